@@ -16,6 +16,7 @@ var (
 
 	LightBlueShadowColor = color.RGBA{0x9b, 0xab, 0xb2, 96}
 	DarkShadowColor      = color.RGBA{0x00, 0x00, 0x00, 32}
+	SplashColor          = color.RGBA{0xf9, 0x6d, 0x3a, 64}
 
 	UIFogColor     = color.RGBA{0x3b, 0x33, 0x42, 96}
 	TextLightColor = color.RGBA{0xb6, 0xa8, 0xbf, 0xff}
@@ -46,6 +47,8 @@ var (
 	SpawningColorMatrix     = colorm.ColorM{}
 	GhostColorMatrix        = colorm.ColorM{}
 	NonBuildableColorMatrix = colorm.ColorM{}
+	FogOfWarColorMatrix     = colorm.ColorM{}
+	DepletedColorMatrix     = colorm.ColorM{}
 )
 
 func init() {
@@ -54,4 +57,6 @@ func init() {
 	SpawningColorMatrix.ChangeHSV(0, 0.5, 0.75)      // De-saturated darkened
 	GhostColorMatrix.Scale(1, 1, 1, 0.65)            // Semi-transparent
 	NonBuildableColorMatrix.Scale(1.25, 0.8, 0.8, 1) // Red tint
+	FogOfWarColorMatrix.ChangeHSV(0, 0.25, 0.5)      // De-saturated darkened
+	DepletedColorMatrix.ChangeHSV(0, 0, 0.6)         // Grayscale darkened
 }
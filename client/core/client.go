@@ -34,6 +34,7 @@ const (
 	KeyFunction_ToggleTargetLines
 	KeyFunction_ToggleDebugInfo
 	KeyFunction_Deselect
+	KeyFunction_TogglePause
 )
 
 type KeyMap map[KeyFunction][]ebiten.Key
@@ -82,16 +83,19 @@ var DefaultKeyMap = KeyMap{
 	KeyFunction_ToggleTargetLines: {ebiten.KeyL},
 	KeyFunction_ToggleDebugInfo:   {ebiten.KeyF3, ebiten.KeyK},
 	KeyFunction_Deselect:          {ebiten.KeyEscape},
+	KeyFunction_TogglePause:       {ebiten.KeyP},
 }
 
 // Selection holds the current selection state.
 type Selection struct {
-	UnitType uint8
+	UnitType     uint8
+	BuildingType uint8
 }
 
 // Clear clears the selection.
 func (s *Selection) Clear() {
 	s.UnitType = 0
+	s.BuildingType = 0
 }
 
 // Main game client object run by ebiten.RunGame.
@@ -182,41 +186,134 @@ func (c *Client) IsSelectingUnitType() bool {
 	return c.selected.UnitType != 0
 }
 
+// Clears the current selection, selects a given building type, and executes the
+// selection side effects.
+func (c *Client) SelectBuildingType(buildingType uint8) {
+	c.ClearSelection()
+	c.selected.BuildingType = buildingType
+
+	if c.onSelectionChange != nil {
+		c.onSelectionChange()
+	}
+}
+
+// Returns the selected building type.
+func (c *Client) SelectedBuildingType() uint8 {
+	return c.selected.BuildingType
+}
+
+// Returns true if a building type is selected.
+func (c *Client) IsSelectingBuildingType() bool {
+	return c.selected.BuildingType != 0
+}
+
 func (c *Client) CreateUnit(unitType uint8, position image.Point) {
 	queue := c.coreRenderer.internalEventQueue
 	c.Headless().CreateUnit(unitType, position)
 	c.coreRenderer.internalEventQueue = queue
 }
 
+func (c *Client) PlaceBuilding(buildingType uint8, position image.Point) {
+	queue := c.coreRenderer.internalEventQueue
+	c.Headless().PlaceBuilding(buildingType, position)
+	c.coreRenderer.internalEventQueue = queue
+}
+
+func (c *Client) CancelUnit(unitId uint8) {
+	queue := c.coreRenderer.internalEventQueue
+	c.Headless().CancelUnit(unitId)
+	c.coreRenderer.internalEventQueue = queue
+}
+
+// Cancels the client player's unpaid or spawning unit at the given tile, if any. Returns true if a
+// unit was cancelled.
+func (c *Client) cancelUnitAt(tilePosition image.Point) bool {
+	tile := c.Game().GetBoardTile(uint16(tilePosition.X), uint16(tilePosition.Y))
+	for layer := rts.LayerId(0); layer < rts.LayerId_Count; layer++ {
+		playerId, unitId := rts.GetTileUnit(tile, layer)
+		if playerId != c.PlayerId() || unitId == rts.NilUnitId {
+			continue
+		}
+		state := rts.UnitState(c.Game().GetUnit(playerId, unitId).GetState())
+		if state == rts.UnitState_Unpaid || state == rts.UnitState_Spawning {
+			c.CancelUnit(unitId)
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the tile under the cursor, or false if the cursor is not over the board.
+func (c *Client) cursorTilePosition() (image.Point, bool) {
+	cursorScreenPosition := image.Pt(ebiten.CursorPosition())
+	if !cursorScreenPosition.In(c.coreRenderer.boardDisplayRect) {
+		return image.Point{}, false
+	}
+	terrainDisplayRect := image.Rectangle{
+		Min: c.coreRenderer.TileCoordToDisplayCoord(image.Pt(0, 0)),
+		Max: c.coreRenderer.TileCoordToDisplayCoord(c.Game().BoardSize()),
+	}
+	if !cursorScreenPosition.In(terrainDisplayRect) {
+		return image.Point{}, false
+	}
+	return c.coreRenderer.ScreenCoordToTileCoord(cursorScreenPosition), true
+}
+
+// Requests a pause, or resumes the match if the client player paused it or already requested it.
+func (c *Client) TogglePause() {
+	if c.Game().IsPaused() {
+		if c.Game().GetMeta().GetPausedBy() == c.PlayerId() {
+			c.Headless().Resume()
+		}
+	} else if c.Game().GetPlayer(c.PlayerId()).GetIsPauseRequested() {
+		c.Headless().Resume()
+	} else {
+		c.Headless().RequestPause()
+	}
+}
+
 func (c *Client) handleInput() {
 	if c.keyMap.IsJustPressed(KeyFunction_Deselect) {
 		c.ClearSelection()
 	}
+	if c.keyMap.IsJustPressed(KeyFunction_TogglePause) {
+		c.TogglePause()
+	}
 	if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonRight) {
+		if !c.IsSelectingUnitType() && !c.IsSelectingBuildingType() {
+			// Right clicking a queued unit with nothing selected cancels it
+			if tilePosition, ok := c.cursorTilePosition(); ok {
+				c.cancelUnitAt(tilePosition)
+			}
+		}
 		c.ClearSelection()
 	}
 
-	cursorScreenPosition := image.Pt(ebiten.CursorPosition())
-
 	if !inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
 		return
 	}
-	if !cursorScreenPosition.In(c.coreRenderer.boardDisplayRect) {
+	tilePosition, ok := c.cursorTilePosition()
+	if !ok {
 		return
 	}
-	terrainDisplayRect := image.Rectangle{
-		Min: c.coreRenderer.TileCoordToDisplayCoord(image.Pt(0, 0)),
-		Max: c.coreRenderer.TileCoordToDisplayCoord(c.Game().BoardSize()),
-	}
-	if !cursorScreenPosition.In(terrainDisplayRect) {
+	tile := c.Game().GetBoardTile(uint16(tilePosition.X), uint16(tilePosition.Y))
+
+	if c.IsSelectingBuildingType() {
+		// The building is placed with its top-left corner at the cursor tile
+		var (
+			proto = c.Game().GetBuildingPrototype(c.SelectedBuildingType())
+			area  = image.Rectangle{Min: tilePosition, Max: tilePosition.Add(rts.GetDimensionsAsPoint(proto))}
+		)
+		if !c.Game().IsBuildableArea(area) {
+			return
+		}
+		c.PlaceBuilding(c.SelectedBuildingType(), tilePosition)
+		c.ClearSelection()
 		return
 	}
 
-	var (
-		tilePosition = c.coreRenderer.ScreenCoordToTileCoord(cursorScreenPosition)
-		tile         = c.Game().GetBoardTile(uint16(tilePosition.X), uint16(tilePosition.Y))
-	)
-	if !rts.IsTileEmptyAllLayers(tile) {
+	layer := rts.LayerId(c.Game().GetUnitPrototype(c.SelectedUnitType()).GetLayer())
+	if !rts.IsTileEmptyAllLayers(tile) || !rts.GetTileTerrain(tile).IsPassable(layer) {
 		return
 	}
 
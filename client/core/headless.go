@@ -7,8 +7,11 @@ import (
 	"github.com/concrete-eth/archetype/arch"
 	arch_client "github.com/concrete-eth/archetype/client"
 	"github.com/concrete-eth/archetype/rpc"
+	"github.com/concrete-eth/archetype/utils"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
 	"github.com/concrete-eth/ark-royale/rts"
 	"github.com/ethereum/go-ethereum/concrete/lib"
+	"github.com/ethereum/go-ethereum/log"
 )
 
 // TODO: read-only player id 0
@@ -32,29 +35,55 @@ type IHeadlessClient interface {
 	SendAction(action arch.Action) error
 	Start()
 	CreateUnit(unitType uint8, position image.Point)
+	PlaceBuilding(buildingType uint8, position image.Point)
+	DemolishBuilding(buildingId uint8)
+	CancelUnit(unitId uint8)
+	Surrender()
+	RequestPause()
+	Resume()
 }
 
 // Implements a headless client that can sync state and send actions.
 type HeadlessClient struct {
 	*arch_client.Client
-	hinter    *rpc.TxHinter
-	playerId  uint8
-	_tickTime time.Duration
+	hinter        *rpc.TxHinter
+	kv            lib.KeyValueStore
+	playerId      uint8
+	stateVerifier *stateVerifier
 }
 
 var _ IHeadlessClient = (*HeadlessClient)(nil)
 
+// Creates a headless client. blockTime and startingBlockNumber must be the ones io was created with.
+// ticksPerBlock is only assumed until the match is initialized (or if kv is empty, until the client
+// syncs the block the match was initialized in) and should match the value the match is initialized
+// with for sub-ticks to be anticipated at the right pace.
+// If recorder is not nil, every action batch received from io is also written to it.
 func NewHeadlessClient(
 	kv lib.KeyValueStore,
 	io *rpc.IO,
+	blockTime time.Duration,
+	startingBlockNumber uint64,
+	ticksPerBlock uint64,
+	recorder *rts.ActionRecorder,
 ) *HeadlessClient {
 	c := &rts.Core{}
-	cli := io.NewClient(kv, c)
+	c.SetDefaultTicksPerBlock(ticksPerBlock)
+	batchChan := io.ActionBatchOutChan()
+	if recorder != nil {
+		batchChan = utils.ProbeChannel(batchChan, func(batch arch.ActionBatch) {
+			if err := recorder.RecordBatch(batch); err != nil {
+				log.Error("Failed to record action batch", "blockNumber", batch.BlockNumber, "err", err)
+			}
+		})
+	}
+	schemas := arch.ArchSchemas{Actions: archmod.ActionSchemas, Tables: archmod.TableSchemas}
+	cli := arch_client.New(schemas, c, kv, batchChan, io.ActionInChan(), blockTime, startingBlockNumber)
 	hinter := io.Hinter()
 	return &HeadlessClient{
-		Client:    cli,
-		hinter:    hinter,
-		_tickTime: cli.BlockTime() / time.Duration(c.TicksPerBlock()),
+		Client: cli,
+		hinter: hinter,
+		kv:     kv,
 	}
 }
 
@@ -62,6 +91,28 @@ func (c *HeadlessClient) Game() *rts.Core {
 	return c.Core().(*rts.Core)
 }
 
+func (c *HeadlessClient) Sync() (bool, bool, error) {
+	didReceiveNewBatch, didTick, err := c.Client.Sync()
+	if didReceiveNewBatch {
+		c.verifyState()
+	}
+	return didReceiveNewBatch, didTick, err
+}
+
+func (c *HeadlessClient) SyncUntil(blockNumber uint64) error {
+	err := c.Client.SyncUntil(blockNumber)
+	c.verifyState()
+	return err
+}
+
+func (c *HeadlessClient) InterpolatedSync() (bool, bool, error) {
+	didReceiveNewBatch, didTick, err := c.Client.InterpolatedSync()
+	if didReceiveNewBatch {
+		c.verifyState()
+	}
+	return didReceiveNewBatch, didTick, err
+}
+
 func (c *HeadlessClient) Hinter() *rpc.TxHinter {
 	return c.hinter
 }
@@ -74,8 +125,9 @@ func (c *HeadlessClient) Hinter() *rpc.TxHinter {
 // 	return c.BlockTime()
 // }
 
+// Returns the time between sub-ticks, i.e. the block time divided by the ticks per block of the match.
 func (c *HeadlessClient) SubTickPeriod() time.Duration {
-	return c._tickTime
+	return c.BlockTime() / time.Duration(c.Game().TicksPerBlock())
 }
 
 // func (c *HeadlessClient) Interpolating() bool {
@@ -106,3 +158,56 @@ func (c *HeadlessClient) CreateUnit(unitType uint8, position image.Point) {
 	}
 	c.SendAction(action)
 }
+
+// Sends a BuildingPlacement action to the Tx sender
+func (c *HeadlessClient) PlaceBuilding(buildingType uint8, position image.Point) {
+	action := &rts.BuildingPlacement{
+		PlayerId:     c.playerId,
+		BuildingType: buildingType,
+		X:            uint16(position.X),
+		Y:            uint16(position.Y),
+	}
+	c.SendAction(action)
+}
+
+// Sends a BuildingDemolition action to the Tx sender
+func (c *HeadlessClient) DemolishBuilding(buildingId uint8) {
+	action := &rts.BuildingDemolition{
+		PlayerId:   c.playerId,
+		BuildingId: buildingId,
+	}
+	c.SendAction(action)
+}
+
+// Sends a UnitCancellation action to the Tx sender
+func (c *HeadlessClient) CancelUnit(unitId uint8) {
+	action := &rts.UnitCancellation{
+		PlayerId: c.playerId,
+		UnitId:   unitId,
+	}
+	c.SendAction(action)
+}
+
+// Sends a Surrender action to the Tx sender
+func (c *HeadlessClient) Surrender() {
+	action := &rts.Surrender{
+		PlayerId: c.playerId,
+	}
+	c.SendAction(action)
+}
+
+// Sends a RequestPause action to the Tx sender
+func (c *HeadlessClient) RequestPause() {
+	action := &rts.PauseRequest{
+		PlayerId: c.playerId,
+	}
+	c.SendAction(action)
+}
+
+// Sends a Resume action to the Tx sender
+func (c *HeadlessClient) Resume() {
+	action := &rts.Resumption{
+		PlayerId: c.playerId,
+	}
+	c.SendAction(action)
+}
@@ -175,6 +175,67 @@ func (bg *UnitGhost) Draw(c *Client, screen *ebiten.Image) {
 	colorm.DrawImage(screen, bg.ghostImage, bg.colorM, op)
 }
 
+// Renders a ghost of the selected building type at the cursor position.
+type BuildingGhost struct {
+	ghostImage        *ebiten.Image
+	ghostBuildingType uint8
+	colorM            colorm.ColorM
+}
+
+var _ UpdatableWithClient = (*BuildingGhost)(nil)
+var _ DrawableWithClient = (*BuildingGhost)(nil)
+
+// Creates a new BuildingGhost component.
+func NewBuildingGhost() *BuildingGhost {
+	return &BuildingGhost{}
+}
+
+// Creates the ghost image by drawing the built building sprite with the ghost color matrix.
+func (bg *BuildingGhost) drawGhost(c *Client, playerId uint8, buildingTypeId uint8) *ebiten.Image {
+	sprite := c.coreRenderer.spriteGetter.GetBuildingSprite(playerId, buildingTypeId, rts.BuildingState_Built)
+	img := ebiten.NewImage(sprite.Bounds().Dx(), sprite.Bounds().Dy())
+	colorm.DrawImage(img, sprite, assets.GhostColorMatrix, nil)
+	return img
+}
+
+func (bg *BuildingGhost) SetColorMatrix(colorM colorm.ColorM) {
+	bg.colorM = colorM
+}
+
+// Redraws the ghost image it if the selected building type has changed.
+func (bg *BuildingGhost) Update(c *Client) {
+	if !c.IsSelectingBuildingType() {
+		bg.ghostBuildingType = 0
+		return
+	}
+	if bg.ghostBuildingType != c.selected.BuildingType {
+		bg.ghostImage = bg.drawGhost(c, c.PlayerId(), c.selected.BuildingType)
+		bg.ghostBuildingType = c.selected.BuildingType
+	}
+}
+
+// Draws the ghost image onto the screen with its top-left corner at the cursor tile.
+func (bg *BuildingGhost) Draw(c *Client, screen *ebiten.Image) {
+	if !c.IsSelectingBuildingType() || bg.ghostImage == nil {
+		return
+	}
+	var (
+		cursorPos    = client_utils.CursorPosition()
+		tilePos      = c.coreRenderer.ScreenCoordToTileCoord(cursorPos)
+		spriteOrigin = c.coreRenderer.spriteGetter.GetBuildingSpriteOrigin(bg.ghostBuildingType)
+		layerPos     = tilePos.Mul(assets.TileSize).Sub(spriteOrigin)
+		screenPos    = c.coreRenderer.TileCoordToScreenCoord(image.Point{}).Add(
+			layerPos.Mul(c.coreRenderer.tileDisplaySize).Div(InternalTileSize),
+		)
+		screenRect = image.Rectangle{
+			Min: screenPos,
+			Max: screenPos.Add(bg.ghostImage.Bounds().Size().Mul(c.coreRenderer.tileDisplaySize).Div(assets.TileSize)),
+		}
+		op = client_utils.NewDrawOptions(screenRect, bg.ghostImage.Bounds())
+	)
+	colorm.DrawImage(screen, bg.ghostImage, bg.colorM, op)
+}
+
 // Renders the range highlights around selected units.
 type RangeHighlights struct {
 	highlightTile *ebiten.Image
@@ -198,7 +259,8 @@ func (rh *RangeHighlights) Draw(c *Client, _ *ebiten.Image) {
 
 	cursorPos := client_utils.CursorPosition()
 	tilePos := c.coreRenderer.ScreenCoordToTileCoord(cursorPos)
-	if !tilePos.In(c.Game().BoardRect()) {
+	if !tilePos.In(c.Game().BoardRect()) || !c.coreRenderer.IsTileVisible(tilePos) {
+		// Do not reveal units hidden by fog of war
 		spriteObj.SetImage(nil)
 		return
 	}
@@ -478,7 +540,8 @@ func (tl *TargetLines) Update(c *Client) {
 
 	cursorPos := client_utils.CursorPosition()
 	tilePos := c.coreRenderer.ScreenCoordToTileCoord(cursorPos)
-	if !tilePos.In(c.Game().BoardRect()) {
+	if !tilePos.In(c.Game().BoardRect()) || !c.coreRenderer.IsTileVisible(tilePos) {
+		// Do not reveal units hidden by fog of war
 		spriteObj.SetImage(nil)
 		return
 	}
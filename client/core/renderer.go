@@ -25,6 +25,10 @@ var (
 	ErrQuit = errors.New("quit")
 )
 
+var (
+	splashFootprintImage = newFillImage(assets.SplashColor)
+)
+
 var (
 	LayerName_Land  = rts.LayerId_Land.String()
 	LayerName_Hover = rts.LayerId_Hover.String()
@@ -64,6 +68,7 @@ type ClientConfig struct {
 type ClientSettings struct {
 	Interpolate bool
 	FixedCamera bool
+	FogOfWar    bool
 }
 
 // Holds a task to be executed at a certain time or block number.
@@ -108,11 +113,6 @@ func (t *TaskSet) Update(c *CoreRenderer) {
 	t.Tasks = pendingTasks
 }
 
-type InternalEvent struct {
-	Id   uint8
-	Data interface{}
-}
-
 type AnticipatedCommand struct {
 	Command uint64
 	Extra   uint64
@@ -123,7 +123,8 @@ type AnticipatedCommand struct {
 type CoreRenderer struct {
 	IHeadlessClient // Embedded headless client
 
-	hintNonce uint64 // Hinter nonce
+	hintNonce   uint64 // Hinter nonce
+	splashNonce uint64 // Splash footprint nonce, one per shot
 
 	config   ClientConfig   // Client configuration (immutable)
 	settings ClientSettings // Client settings (modifiable)
@@ -148,12 +149,14 @@ type CoreRenderer struct {
 	tableUpdatedObjects map[rts.Object]struct{}         // Objects whose table has been updated and need to be reset
 	direction           map[rts.Object]assets.Direction // Current direction of objects
 	anticipating        bool                            // True when actions and ticks are being anticipated
+	vision              *rts.VisionMap                  // Tiles visible to the client player, nil if fog of war is disabled
+	seenBuildings       map[rts.Object]struct{}         // Enemy buildings that have been in vision at least once
 	// simulating          bool                            // True when simulating sub-ticks or actions
 
 	lastSubTickTime       time.Time // Last tick time
 	lastInterpolationTime time.Time // Last sub-tick time
 
-	internalEventQueue []InternalEvent // Internal event buffer
+	internalEventQueue []rts.InternalEvent // Internal event buffer
 
 	onNewBatch   func() // On new batch callback
 	onCameraMove func() // On camera move callback
@@ -192,7 +195,7 @@ func NewCoreRenderer(headlessClient IHeadlessClient, config ClientConfig, sprite
 	c := &CoreRenderer{
 		IHeadlessClient: headlessClient,
 		config:          config,
-		settings:        ClientSettings{Interpolate: true, FixedCamera: true},
+		settings:        ClientSettings{Interpolate: true, FixedCamera: true, FogOfWar: true},
 
 		hintNonce: 0,
 
@@ -205,10 +208,11 @@ func NewCoreRenderer(headlessClient IHeadlessClient, config ClientConfig, sprite
 		tableUpdatedObjects: make(map[rts.Object]struct{}),
 		direction:           make(map[rts.Object]assets.Direction),
 		anticipating:        false,
+		seenBuildings:       make(map[rts.Object]struct{}),
 
 		lastSubTickTime:       headlessClient.LastNewBatchTime(),
 		lastInterpolationTime: headlessClient.LastNewBatchTime(),
-		internalEventQueue:    make([]InternalEvent, 0),
+		internalEventQueue:    make([]rts.InternalEvent, 0),
 
 		spriteGetter: spriteGetter,
 	}
@@ -225,10 +229,11 @@ func NewCoreRenderer(headlessClient IHeadlessClient, config ClientConfig, sprite
 	camPos.Y += InternalTileSize / 2
 	c.setCamera(camPos, 0)
 
-	c.Game().SetEventHandler(c.onInternalEvent)
+	c.Game().Subscribe(c.onInternalEvent)
 	c.anticipateSubTick()
 
 	// Render the current state
+	c.updateVision()
 	c.setAllBuildingSprites()
 	c.setAllUnitSprites()
 
@@ -237,12 +242,12 @@ func NewCoreRenderer(headlessClient IHeadlessClient, config ClientConfig, sprite
 
 func (c *CoreRenderer) Simulate(f func(_core arch.Core)) {
 	// c.simulating = true
-	eh := c.Game().EventHandler()
-	c.Game().SetEventHandler(nil)
+	muted := c.Game().EventsMuted()
+	c.Game().SetEventsMuted(true)
 	sh := c.Game().SetFieldHandler()
 	c.Game().SetSetFieldHandler(nil)
 	c.IHeadlessClient.Simulate(f)
-	c.Game().SetEventHandler(eh)
+	c.Game().SetEventsMuted(muted)
 	c.Game().SetSetFieldHandler(sh)
 	// c.simulating = false
 }
@@ -283,6 +288,38 @@ func (c *CoreRenderer) SetOnCameraMove(onCameraMove func()) {
 	c.onCameraMove = onCameraMove
 }
 
+// Recomputes the tiles visible to the client player.
+func (c *CoreRenderer) updateVision() {
+	playerId := c.PlayerId()
+	if !c.settings.FogOfWar || playerId == rts.NilPlayerId {
+		c.vision = nil
+		return
+	}
+	c.vision = c.Game().GetVisibleTiles(playerId)
+}
+
+// Returns true if the objects of the given player can be hidden by fog of war.
+func (c *CoreRenderer) isFogged(playerId uint8) bool {
+	return c.vision != nil && playerId != rts.NilPlayerId && playerId != c.PlayerId()
+}
+
+// Returns true if the tile is visible to the client player.
+func (c *CoreRenderer) IsTileVisible(position image.Point) bool {
+	return c.vision == nil || c.vision.IsVisible(position)
+}
+
+// Returns true if the object is hidden by fog of war.
+func (c *CoreRenderer) IsObjectHidden(object rts.Object) bool {
+	if !c.isFogged(object.PlayerId) {
+		return false
+	}
+	if object.Type == rts.ObjectType_Building {
+		return !c.vision.IsAreaVisible(c.Game().GetBuildingArea(object.PlayerId, object.ObjectId))
+	}
+	unit := c.Game().GetUnit(object.PlayerId, object.ObjectId)
+	return !c.vision.IsVisible(rts.GetPositionAsPoint(unit))
+}
+
 func (c *CoreRenderer) setAllBuildingSprites() {
 	nPlayers := c.Game().GetMeta().GetPlayerCount()
 	for playerId := uint8(0); playerId < nPlayers+1; playerId++ {
@@ -302,33 +339,32 @@ func (c *CoreRenderer) setAllUnitSprites() {
 }
 
 // Called when an internal event is emitted by the rts.
-func (c *CoreRenderer) onInternalEvent(eventId uint8, data interface{}) {
+func (c *CoreRenderer) onInternalEvent(event rts.InternalEvent) {
 	// if c.simulating {
 	// 	return
 	// }
-	event := InternalEvent{
-		Id:   eventId,
-		Data: data,
-	}
 	c.internalEventQueue = append(c.internalEventQueue, event)
 }
 
 func (c *CoreRenderer) handleInternalEvents() {
 	for _, event := range c.internalEventQueue {
-		c.handleInternalEvent(event.Id, event.Data)
+		c.handleInternalEvent(event)
 	}
-	c.internalEventQueue = make([]InternalEvent, 0)
-}
-
-func (c *CoreRenderer) handleInternalEvent(eventId uint8, data interface{}) {
-	if eventId == rts.InternalEventId_Shot {
-		c.onShotEvent(data.(*rts.InternalEvent_Shot))
-	} else if eventId == rts.InternalEventId_Spawned {
-		c.onSpawnedEvent(data.(*rts.InternalEvent_Spawned))
-	} else if eventId == rts.InternalEventId_Killed {
-		c.onKilledEvent(data.(*rts.InternalEvent_Killed))
-	} else if eventId == rts.InternalEventId_Built {
-		c.onBuiltEvent(data.(*rts.InternalEvent_Built))
+	c.internalEventQueue = make([]rts.InternalEvent, 0)
+}
+
+func (c *CoreRenderer) handleInternalEvent(event rts.InternalEvent) {
+	switch event := event.(type) {
+	case *rts.InternalEvent_Shot:
+		c.onShotEvent(event)
+	case *rts.InternalEvent_Spawned:
+		c.onSpawnedEvent(event)
+	case *rts.InternalEvent_Killed:
+		c.onKilledEvent(event)
+	case *rts.InternalEvent_Built:
+		c.onBuiltEvent(event)
+	case *rts.InternalEvent_Recycled:
+		c.onRecycledEvent(event)
 	}
 }
 
@@ -748,7 +784,20 @@ func (c *CoreRenderer) setBuildingSprite(playerId uint8, buildingId uint8, build
 	healthBarSpriteObj := c.getHealthBarSpriteObject(object)
 	buildBarSpriteObj := c.getBuildBarSpriteObject(object)
 
+	if !buildingState.IsNil() && !c.anticipating && c.IsObjectHidden(object) {
+		if _, ok := c.seenBuildings[object]; ok {
+			// Keep the last known sprite shaded
+			spriteObj.SetColorMatrix(assets.FogOfWarColorMatrix)
+		} else {
+			spriteObj.SetImage(nil)
+		}
+		healthBarSpriteObj.SetImage(nil)
+		buildBarSpriteObj.SetImage(nil)
+		return
+	}
+
 	if buildingState.IsNil() || buildingState == rts.BuildingState_Destroyed {
+		delete(c.seenBuildings, object)
 		// Remove the sprites if the building is nil, cancelled or destroyed
 		flashDuration := time.Second / time.Duration(8)
 		c.tasks.AddTask(&ScheduledTask{
@@ -767,6 +816,8 @@ func (c *CoreRenderer) setBuildingSprite(playerId uint8, buildingId uint8, build
 		if buildingState != rts.BuildingState_Unpaid && buildingState != rts.BuildingState_Building {
 			return
 		}
+	} else if c.isFogged(playerId) {
+		c.seenBuildings[object] = struct{}{}
 	}
 
 	var (
@@ -786,6 +837,9 @@ func (c *CoreRenderer) setBuildingSprite(playerId uint8, buildingId uint8, build
 	if buildingState == rts.BuildingState_Unpaid {
 		colorM.Concat(assets.UnpaidColorMatrix)
 	}
+	if buildingState == rts.BuildingState_Depleted {
+		colorM.Concat(assets.DepletedColorMatrix)
+	}
 	if c.anticipating {
 		colorM.Concat(assets.AnticipatedColorMatrix)
 	}
@@ -860,6 +914,10 @@ func (c *CoreRenderer) setUnitSprite(playerId uint8, unitId uint8, unit *datamod
 		c.tasks.AddTask(&ScheduledTask{
 			Time: time.Now().Add(flashDuration),
 			Func: func() {
+				if rts.UnitState(c.Game().GetUnit(playerId, unitId).GetState()) != rts.UnitState_Dead {
+					// The unit id has been recycled, the sprites now belong to the new unit
+					return
+				}
 				c.deletePosition(object)
 				healthBarSpriteObj.Delete()
 				spriteObj.Delete()
@@ -885,6 +943,10 @@ func (c *CoreRenderer) setUnitSprite(playerId uint8, unitId uint8, unit *datamod
 		if unitState != rts.UnitState_Unpaid && unitState != rts.UnitState_Spawning {
 			return
 		}
+	} else if c.IsObjectHidden(object) {
+		spriteObj.SetImage(nil)
+		healthBarSpriteObj.SetImage(nil)
+		return
 	}
 
 	c.setUnitSpriteImage(playerId, unitId, unit)
@@ -1066,7 +1128,9 @@ func (c *CoreRenderer) onShotEvent(shot *rts.InternalEvent_Shot) {
 	var targetSpriteObj *decren.Sprite
 	var imgOverride *ebiten.Image
 
-	if targetType == rts.ObjectType_Building {
+	if c.IsObjectHidden(shot.Target) {
+		// Do not reveal hidden targets
+	} else if targetType == rts.ObjectType_Building {
 		building := c.Game().GetBuilding(targetPlayerId, targetId)
 		protoId := building.GetBuildingType()
 		imgOverride = c.spriteGetter.GetBuildingSprite(targetPlayerId, protoId, rts.BuildingState_Built)
@@ -1078,12 +1142,25 @@ func (c *CoreRenderer) onShotEvent(shot *rts.InternalEvent_Shot) {
 		imgOverride = c.spriteGetter.GetUnitSprite(targetPlayerId, protoId, unitDirection)
 		targetSpriteObj = c.getUnitSpriteObject(targetPlayerId, targetId, unit)
 	}
-	c.animations.RunAnimation(NewFlashAnimation(targetSpriteObj, imgOverride), AnimationConfig{
-		FPS:  8,
-		Mode: AnimationMode_Once,
-	})
+	if targetSpriteObj != nil {
+		c.animations.RunAnimation(NewFlashAnimation(targetSpriteObj, imgOverride), AnimationConfig{
+			FPS:  8,
+			Mode: AnimationMode_Once,
+		})
+	}
+
+	if shot.IsSplash {
+		// The attacker fire animation and explosion footprint are triggered by the primary shot
+		return
+	}
+	if targetType == rts.ObjectType_Unit && !c.IsObjectHidden(shot.Target) {
+		c.showSplashFootprint(shot)
+	}
 
 	// Trigger a fire animation on the attacker
+	if c.IsObjectHidden(shot.Attacker) {
+		return
+	}
 	var (
 		attackerDirection = c.direction[shot.Attacker]
 		attackerUnit      = c.Game().GetUnit(shot.Attacker.PlayerId, shot.Attacker.ObjectId)
@@ -1096,6 +1173,52 @@ func (c *CoreRenderer) onShotEvent(shot *rts.InternalEvent_Shot) {
 	})
 }
 
+// Briefly shades the area damaged by a shot from a unit with splash damage.
+func (c *CoreRenderer) showSplashFootprint(shot *rts.InternalEvent_Shot) {
+	var (
+		attackerUnit    = c.Game().GetUnit(shot.Attacker.PlayerId, shot.Attacker.ObjectId)
+		attackerProto   = c.Game().GetUnitPrototype(attackerUnit.GetUnitType())
+		splashRadius    = int(attackerProto.GetSplashRadius())
+		targetUnit      = c.Game().GetUnit(shot.Target.PlayerId, shot.Target.ObjectId)
+		targetPosition  = rts.GetPositionAsPoint(targetUnit)
+		hudTerrainLayer = c.worldLayers.Layer(LayerName_HudTerrain)
+	)
+	if splashRadius == 0 {
+		return
+	}
+	area := image.Rectangle{
+		Min: targetPosition.Sub(image.Point{splashRadius, splashRadius}),
+		Max: targetPosition.Add(image.Point{splashRadius + 1, splashRadius + 1}),
+	}.Intersect(c.Game().BoardRect())
+
+	// Key the footprint by shot so removing an old footprint does not remove a newer one
+	c.splashNonce++
+	spriteObj := hudTerrainLayer.Sprite("splash", c.splashNonce)
+	spriteObj.SetImage(splashFootprintImage).SetRect(image.Rectangle{
+		Min: c.TileCoordToScreenCoord(area.Min),
+		Max: c.TileCoordToScreenCoord(area.Max),
+	})
+
+	flashDuration := time.Second / time.Duration(4)
+	c.tasks.AddTask(&ScheduledTask{
+		Time: time.Now().Add(flashDuration),
+		Func: func() {
+			spriteObj.Delete()
+		},
+	})
+}
+
+// Clear the sprites and interpolation state left behind by the dead unit whose id was recycled
+func (c *CoreRenderer) onRecycledEvent(recycled *rts.InternalEvent_Recycled) {
+	object := recycled.Unit
+	c.deleteUndefinedUnitSpriteObject(object.PlayerId, object.ObjectId)
+	c.getHealthBarSpriteObject(object).Delete()
+	c.deletePosition(object)
+	delete(c.nextTilePosition, object)
+	delete(c.direction, object)
+	delete(c.anticipatedCommands, object)
+}
+
 // Remove unit spawn bar when a unit spawns
 func (c *CoreRenderer) onSpawnedEvent(spawn *rts.InternalEvent_Spawned) {
 	healthBarSpriteObj := c.getHealthBarSpriteObject(spawn.Unit)
@@ -1204,6 +1327,7 @@ func (c *CoreRenderer) Update() error {
 	if newBatch || subTicked {
 		c.lastSubTickTime = time.Now()
 		c.anticipateSubTick()
+		c.updateVision()
 		c.handleInternalEvents()
 		c.setAllBuildingSprites()
 		c.setAllUnitSprites()
@@ -1294,3 +1418,10 @@ func NewProgressBar(size image.Point, value float64, clr color.Color) *ebiten.Im
 	colorm.DrawImage(track, bar, colorm.ColorM{}, op)
 	return track
 }
+
+// Create a new 1x1 image filled with the given color.
+func newFillImage(clr color.Color) *ebiten.Image {
+	img := ebiten.NewImage(1, 1)
+	img.Fill(clr)
+	return img
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/concrete-eth/archetype/arch"
+	arch_client "github.com/concrete-eth/archetype/client"
+	"github.com/concrete-eth/archetype/kvstore"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/concrete-eth/ark-royale/rts"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Creates a headless client that plays back a replay instead of syncing from the chain. One block
+// of the replay is fed to the client every blockTime, including the empty blocks the replay skips.
+// Actions sent by the client are dropped.
+func NewReplayClient(r io.Reader, blockTime time.Duration) (*HeadlessClient, error) {
+	reader, err := rts.NewActionBatchReader(r)
+	if err != nil {
+		return nil, err
+	}
+	first, err := reader.Next()
+	if err == io.EOF {
+		return nil, errors.New("replay has no action batches")
+	} else if err != nil {
+		return nil, err
+	}
+
+	c := &rts.Core{}
+	c.SetDefaultTicksPerBlock(reader.TicksPerBlock())
+	batchChan := make(chan arch.ActionBatch)
+	go func() {
+		ticker := time.NewTicker(blockTime)
+		defer ticker.Stop()
+		var (
+			batch       = first
+			blockNumber = first.BlockNumber
+			err         error
+		)
+		for {
+			for ; blockNumber < batch.BlockNumber; blockNumber++ {
+				<-ticker.C
+				batchChan <- arch.NewActionBatch(blockNumber, []arch.Action{})
+			}
+			<-ticker.C
+			batchChan <- batch
+			blockNumber++
+			if batch, err = reader.Next(); err == io.EOF {
+				log.Info("Replay finished", "blockNumber", blockNumber-1)
+				return
+			} else if err != nil {
+				log.Error("Failed to read replay", "err", err)
+				return
+			}
+		}
+	}()
+
+	kv := kvstore.NewMemoryKeyValueStore()
+	schemas := arch.ArchSchemas{Actions: archmod.ActionSchemas, Tables: archmod.TableSchemas}
+	cli := arch_client.New(schemas, c, kv, batchChan, make(chan []arch.Action), blockTime, first.BlockNumber)
+	return &HeadlessClient{
+		Client: cli,
+		kv:     kv,
+	}, nil
+}
@@ -26,6 +26,7 @@ const (
 // IDs to reference UI elements in the UIManager.
 const (
 	UI_ButtonType_UnitIcon = iota
+	UI_ButtonType_BuildingIcon
 	UI_ProgressBar_Resource
 	UI_Id_Count
 )
@@ -48,7 +49,8 @@ type UIManager struct {
 	containers   map[int]*widget.Container      // Container widgets
 	buttonPress  *buttonPress                   // Last button press event
 
-	menuUnitPrototypeIds []uint8
+	menuUnitPrototypeIds     []uint8
+	menuBuildingPrototypeIds []uint8
 
 	spriteGetter assets.SpriteGetter
 }
@@ -57,16 +59,18 @@ type UIManager struct {
 func NewUI(
 	client *Client,
 	menuUnitPrototypeIds []uint8,
+	menuBuildingPrototypeIds []uint8,
 	spriteGetter assets.SpriteGetter,
 ) *UIManager {
 	uim := &UIManager{
-		client:               client,
-		progressBars:         make(map[int]*widget.ProgressBar),
-		labels:               make(map[int]*widget.Text),
-		buttons:              make(map[int]map[int]*widget.Button),
-		containers:           make(map[int]*widget.Container),
-		menuUnitPrototypeIds: menuUnitPrototypeIds,
-		spriteGetter:         spriteGetter,
+		client:                   client,
+		progressBars:             make(map[int]*widget.ProgressBar),
+		labels:                   make(map[int]*widget.Text),
+		buttons:                  make(map[int]map[int]*widget.Button),
+		containers:               make(map[int]*widget.Container),
+		menuUnitPrototypeIds:     menuUnitPrototypeIds,
+		menuBuildingPrototypeIds: menuBuildingPrototypeIds,
+		spriteGetter:             spriteGetter,
 	}
 
 	rootContainer := widget.NewContainer(
@@ -93,7 +97,7 @@ func (m *UIManager) SpriteGetter() assets.SpriteGetter {
 }
 
 func (m *UIManager) Regenerate() *UIManager {
-	return NewUI(m.client, m.menuUnitPrototypeIds, m.spriteGetter)
+	return NewUI(m.client, m.menuUnitPrototypeIds, m.menuBuildingPrototypeIds, m.spriteGetter)
 }
 
 // Adds a progress bar widget to the UI.
@@ -160,7 +164,7 @@ func (m *UIManager) Draw(screen *ebiten.Image) {
 	m.eui.Draw(screen)
 }
 
-// Updates the unit menu by enabling/disabling unit icons based on whether the player has an armory.
+// Updates the unit and building menus by enabling/disabling icons based on the player's resource capacity.
 func (m *UIManager) updateCreationMenu() {
 	var (
 		player           = m.client.Game().GetPlayer(m.client.PlayerId())
@@ -184,6 +188,12 @@ func (m *UIManager) updateCreationMenu() {
 		insufficientResourceCapacity := proto.GetResourceCost() > resourceCapacity
 		button.GetWidget().Disabled = insufficientResourceCapacity
 	}
+	for _, protoId := range m.menuBuildingPrototypeIds {
+		proto := m.client.Game().GetBuildingPrototype(protoId)
+		button := m.GetButton(UI_ButtonType_BuildingIcon, int(protoId))
+		insufficientResourceCapacity := proto.GetResourceCost() > resourceCapacity
+		button.GetWidget().Disabled = insufficientResourceCapacity
+	}
 }
 
 // Sets the resource indicators.
@@ -235,9 +245,18 @@ func newCenterMenu(uim *UIManager) *widget.Container {
 		)),
 	)
 	resourceInfo := newResourceDisplay(uim)
-	unitMenu := newUnitMenu(uim, uim.menuUnitPrototypeIds)
+	creationMenus := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(StandardSpacing),
+		)),
+	)
+	creationMenus.AddChild(newUnitMenu(uim, uim.menuUnitPrototypeIds))
+	if len(uim.menuBuildingPrototypeIds) > 0 {
+		creationMenus.AddChild(newBuildingMenu(uim, uim.menuBuildingPrototypeIds))
+	}
 	container.AddChild(resourceInfo)
-	container.AddChild(unitMenu)
+	container.AddChild(creationMenus)
 	return container
 }
 
@@ -278,6 +297,34 @@ func newUnitIcon(uim *UIManager, protoId uint8, size int) *widget.Button {
 	return button
 }
 
+func newBuildingMenu(uim *UIManager, buildingPrototypeIds []uint8) *widget.Container {
+	container := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceSimple(assets.UIBox_Small, assets.UICornerSize_Small, assets.UICornerSize_Small)),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Padding(widget.NewInsetsSimple(assets.UICornerSize_Small)),
+		)),
+	)
+	for _, protoId := range buildingPrototypeIds {
+		container.AddChild(newBuildingIcon(uim, protoId, IconSize))
+	}
+	return container
+}
+
+func newBuildingIcon(uim *UIManager, protoId uint8, size int) *widget.Button {
+	proto := uim.client.Game().GetBuildingPrototype(protoId)
+	cost := int(proto.GetResourceCost())
+	sprite := uim.spriteGetter.GetBuildingSprite(uim.client.PlayerId(), protoId, rts.BuildingState_Built)
+	buttonImage := newIconButtonImage(sprite, size, assets.UICornerSize_Small*2, cost)
+	button := newIconButton(
+		buttonImage,
+		uim.newButtonPressHandler(UI_ButtonType_BuildingIcon, int(protoId)),
+		size,
+	)
+	uim.RegisterButton(UI_ButtonType_BuildingIcon, int(protoId), button)
+	return button
+}
+
 func newIconButton(buttonImage *widget.ButtonImage, handler widget.ButtonPressedHandlerFunc, size int) *widget.Button {
 	button := widget.NewButton(
 		widget.ButtonOpts.Image(buttonImage),
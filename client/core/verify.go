@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/concrete-eth/archetype/rpc"
+	game_contract "github.com/concrete-eth/ark-royale/gogen/abigen/game"
+	"github.com/concrete-eth/ark-royale/rts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ChainStateHash returns the canonical state hash computed by the game contract at the given block.
+// See rts.ComputeStateHash.
+func ChainStateHash(ctx context.Context, caller bind.ContractCaller, gameAddress common.Address, blockNumber uint64) (common.Hash, error) {
+	gameCaller, err := game_contract.NewContractCaller(gameAddress, caller)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)}
+	hash, err := gameCaller.StateHash(opts)
+	return common.Hash(hash), err
+}
+
+type stateVerifier struct {
+	caller      bind.ContractCaller
+	gameAddress common.Address
+}
+
+// EnableStateVerification makes the client compare the local state hash against the chain state hash
+// after every block it applies and log any divergence.
+func (c *HeadlessClient) EnableStateVerification(caller bind.ContractCaller, gameAddress common.Address) {
+	c.stateVerifier = &stateVerifier{
+		caller:      caller,
+		gameAddress: gameAddress,
+	}
+}
+
+// verifyState checks the committed state against the chain state at the last applied block.
+func (c *HeadlessClient) verifyState() {
+	v := c.stateVerifier
+	if v == nil {
+		return
+	}
+	// The core block number points to the next block to be applied
+	blockNumber := c.Game().BlockNumber() - 1
+
+	// Hash the committed state only, excluding any anticipated ticks
+	committed := &rts.Core{}
+	committed.SetKV(c.kv)
+	localHash := committed.StateHash()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), rpc.StandardTimeout)
+		defer cancel()
+		chainHash, err := ChainStateHash(ctx, v.caller, v.gameAddress, blockNumber)
+		if err != nil {
+			log.Warn("Failed to get chain state hash", "blockNumber", blockNumber, "err", err)
+			return
+		}
+		if localHash != chainHash {
+			log.Error("State divergence detected", "blockNumber", blockNumber, "local", localHash, "chain", chainHash)
+		} else {
+			log.Debug("State verified", "blockNumber", blockNumber, "hash", localHash)
+		}
+	}()
+}
@@ -21,6 +21,7 @@ type Client struct {
 	shownLoseScreen bool // True if the lose screen is shown
 	shownEndScreen  bool // True if the end screen is shown
 	unitGhost       *core.UnitGhost
+	buildingGhost   *core.BuildingGhost
 }
 
 func NewClient(headlessClient core.IHeadlessClient, config core.ClientConfig, active bool) *Client {
@@ -39,11 +40,12 @@ func NewClient(headlessClient core.IHeadlessClient, config core.ClientConfig, ac
 		uim          = NewUI(cli, SpriteGetter)
 	)
 	c := &Client{
-		Client:    cli,
-		uim:       uim,
-		unitGhost: core.NewUnitGhost(),
+		Client:        cli,
+		uim:           uim,
+		unitGhost:     core.NewUnitGhost(),
+		buildingGhost: core.NewBuildingGhost(),
 	}
-	hudSet.AddComponents(c.unitGhost)
+	hudSet.AddComponents(c.unitGhost, c.buildingGhost)
 
 	cli.SetOnSelectionChange(func() {
 		c.toggleShowSpawnArea(c.IsSelectingUnitType())
@@ -53,6 +55,7 @@ func NewClient(headlessClient core.IHeadlessClient, config core.ClientConfig, ac
 	})
 	cli.CoreRenderer().SetOnNewBatch(func() {
 		c.checkGameOver()
+		c.uim.SetShowPauseScreen(c.Game().IsPaused())
 	})
 
 	return c
@@ -100,39 +103,28 @@ func (c *Client) checkGameOver() {
 	if c.shownEndScreen {
 		return
 	}
-	activePlayers := []uint8{}
+	if meta := c.Game().GetMeta(); meta.GetIsGameOver() {
+		c.uim.ShowEndScreen(meta.GetWinnerId(), c.Game().EndedOutOfTime())
+		c.shownEndScreen = true
+		return
+	}
+	if c.shownLoseScreen {
+		return
+	}
+	// The match goes on while other teams fight, but the client player's team may already be out
+	lost := true
 	c.Game().ForEachPlayer(func(playerId uint8, player *datamod.PlayersRow) {
-		// Check for active main building
-		mainBuilding := c.Game().GetBuilding(playerId, 1)
+		mainBuilding := c.Game().GetMainBuilding(playerId)
 		if mainBuilding.GetState() == uint8(rts.BuildingState_Destroyed) {
 			return
 		}
-		activePlayers = append(activePlayers, playerId)
-	})
-	if len(activePlayers) == 0 {
-		c.uim.ShowEndScreen(rts.NilPlayerId, false)
-		c.shownEndScreen = true
-	} else if len(activePlayers) == 1 {
-		c.uim.ShowEndScreen(activePlayers[0], false)
-		c.shownEndScreen = true
-	} else if !c.shownLoseScreen {
-		lost := true
-		for _, playerId := range activePlayers {
-			if playerId == c.PlayerId() {
-				lost = false
-				break
-			}
-		}
-		if lost {
-			c.uim.ShowLoseScreen()
-			c.shownLoseScreen = true
+		if c.Game().AreAllies(playerId, c.PlayerId()) {
+			lost = false
 		}
-	}
-
-	creationBlockNumber := c.Game().GetMeta().GetCreationBlockNumber()
-	if uint32(c.Game().BlockNumber())-creationBlockNumber > MaxTicks {
-		c.uim.ShowEndScreen(rts.NilPlayerId, true)
-		c.shownEndScreen = true
+	})
+	if lost {
+		c.uim.ShowLoseScreen()
+		c.shownLoseScreen = true
 	}
 }
 
@@ -166,6 +158,25 @@ func (c *Client) setUnitGhostColor() {
 	}
 }
 
+// Tints the building ghost red if the building cannot be placed at the cursor position.
+func (c *Client) setBuildingGhostColor() {
+	if !c.IsSelectingBuildingType() {
+		return
+	}
+	var (
+		cursorScreenPosition = client_utils.CursorPosition()
+		tilePosition         = c.CoreRenderer().ScreenCoordToTileCoord(cursorScreenPosition)
+		proto                = c.Game().GetBuildingPrototype(c.SelectedBuildingType())
+		size                 = rts.GetDimensionsAsPoint(proto)
+		buildArea            = image.Rectangle{Min: tilePosition, Max: tilePosition.Add(size)}
+	)
+	if c.Game().IsBuildableArea(buildArea) {
+		c.buildingGhost.SetColorMatrix(colorm.ColorM{})
+	} else {
+		c.buildingGhost.SetColorMatrix(assets.NonBuildableColorMatrix)
+	}
+}
+
 func (c *Client) Update() error {
 	c.debugChangePlayer()
 
@@ -175,6 +186,7 @@ func (c *Client) Update() error {
 	c.uim.Update()
 
 	c.setUnitGhostColor()
+	c.setBuildingGhostColor()
 
 	// Win screen
 	if c.uim.IsShowingEndScreen() {
@@ -188,8 +200,8 @@ func (c *Client) Update() error {
 	uiButtonClick := c.uim.PopButtonPress()
 	if uiButtonClick != nil {
 		switch uiButtonClick.ButtonType {
-		// case core.UI_ButtonType_BuildingIcon:
-		// 	c.SelectUnitType(uint8(uiButtonClick.ButtonId))
+		case core.UI_ButtonType_BuildingIcon:
+			c.SelectBuildingType(uint8(uiButtonClick.ButtonId))
 		case core.UI_ButtonType_UnitIcon:
 			c.SelectUnitType(uint8(uiButtonClick.ButtonId))
 		}
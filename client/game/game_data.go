@@ -4,6 +4,9 @@ const (
 	BuildingPrototypeId_Main uint8 = iota + 1
 	BuildingPrototypeId_Pit
 	BuildingPrototypeId_Mine
+	BuildingPrototypeId_Storage
+	BuildingPrototypeId_Lab
+	BuildingPrototypeId_Armory
 )
 
 const (
@@ -15,10 +18,6 @@ const (
 )
 
 var (
-	BuildableBuildingPrototypeIds = []uint8{}
+	BuildableBuildingPrototypeIds = []uint8{BuildingPrototypeId_Storage, BuildingPrototypeId_Lab, BuildingPrototypeId_Armory}
 	UnitPrototypeIds              = []uint8{UnitPrototypeId_Air, UnitPrototypeId_AntiAir, UnitPrototypeId_Tank}
 )
-
-const (
-	MaxTicks = 1800
-)
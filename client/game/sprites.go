@@ -19,8 +19,11 @@ type spriteGetter struct {
 
 var (
 	buildingProtoIdToSpriteId = map[uint8]uint8{
-		BuildingPrototypeId_Main: assets.BuildingSpriteId_Main,
-		BuildingPrototypeId_Mine: assets.BuildingSpriteId_SmallMine,
+		BuildingPrototypeId_Main:    assets.BuildingSpriteId_Main,
+		BuildingPrototypeId_Mine:    assets.BuildingSpriteId_SmallMine,
+		BuildingPrototypeId_Storage: assets.BuildingSpriteId_Storage,
+		BuildingPrototypeId_Lab:     assets.BuildingSpriteId_Lab,
+		BuildingPrototypeId_Armory:  assets.BuildingSpriteId_Armory,
 	}
 	unitProtoIdToSpriteId = map[uint8]uint8{
 		UnitPrototypeId_AntiAir: assets.UnitSpriteId_AntiAir,
@@ -13,6 +13,7 @@ import (
 const (
 	UI_Label_WinScreen = iota + core.UI_Id_Count
 	UI_Container_EndScreen
+	UI_Container_PauseScreen
 	UI_Id_Count
 )
 
@@ -22,11 +23,13 @@ type UI struct {
 
 func NewUI(cli *core.Client, spriteGetter assets.SpriteGetter) *UI {
 	ui := &UI{
-		UIManager: core.NewUI(cli, UnitPrototypeIds, spriteGetter),
+		UIManager: core.NewUI(cli, UnitPrototypeIds, BuildableBuildingPrototypeIds, spriteGetter),
 	}
 
 	endScreenContainer := newEndScreenContainer(ui)
+	pauseScreenContainer := newPauseScreenContainer(ui)
 	rootContainer := ui.UI().Container
+	rootContainer.AddChild(pauseScreenContainer)
 	rootContainer.AddChild(endScreenContainer)
 
 	return ui
@@ -57,12 +60,24 @@ func (m *UI) ShowEndScreen(winnerId uint8, outOfTime bool) {
 		label.Label = "Out of Time!"
 	} else if winnerId == rts.NilPlayerId {
 		label.Label = "Mutual Annihilation!"
+	} else if teamId := m.Client().Game().GetPlayer(winnerId).GetTeamId(); teamId != rts.NilTeamId {
+		label.Label = fmt.Sprintf("Team %d Wins!", teamId)
 	} else {
 		label.Label = fmt.Sprintf("Player %d Wins!", winnerId)
 	}
 	container.GetWidget().Visibility = widget.Visibility_Show
 }
 
+// Shows or hides the overlay displayed while the match is paused.
+func (m *UI) SetShowPauseScreen(show bool) {
+	container := m.GetContainer(UI_Container_PauseScreen)
+	if show {
+		container.GetWidget().Visibility = widget.Visibility_Show
+	} else {
+		container.GetWidget().Visibility = widget.Visibility_Hide
+	}
+}
+
 func (ui *UI) Regenerate() *UI {
 	return NewUI(ui.Client(), ui.SpriteGetter())
 }
@@ -118,3 +133,54 @@ func newEndScreenContainer(ui *UI) *widget.Container {
 
 	return container
 }
+
+func newPauseScreenContainer(ui *UI) *widget.Container {
+	container := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(assets.UIFogColor)),
+		widget.ContainerOpts.Layout((widget.NewAnchorLayout())),
+	)
+
+	boxContainer := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceSimple(assets.UIBox_Big, assets.UICornerSize_Big, 2)),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				VerticalPosition:   widget.AnchorLayoutPositionCenter,
+			}),
+		),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Top:    24,
+				Left:   36,
+				Right:  36,
+				Bottom: 24,
+			}),
+			widget.RowLayoutOpts.Spacing(4*core.StandardSpacing),
+		)),
+	)
+
+	pausedLabel := widget.NewText(
+		widget.TextOpts.Text("Paused", assets.GetFontFace(assets.Font_PressStart, 16), assets.TextLightColor),
+		widget.TextOpts.Position(widget.TextPositionCenter, widget.TextPositionCenter),
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+	)
+	resumeLabel := widget.NewText(
+		widget.TextOpts.Text("Press P to Resume", assets.GetFontFace(assets.Font_PressStart, 8), assets.TextDarkColor),
+		widget.TextOpts.Position(widget.TextPositionCenter, widget.TextPositionCenter),
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+	)
+
+	boxContainer.AddChild(pausedLabel)
+	boxContainer.AddChild(resumeLabel)
+	container.AddChild(boxContainer)
+
+	container.GetWidget().Visibility = widget.Visibility_Hide
+	ui.RegisterContainer(UI_Container_PauseScreen, container)
+
+	return container
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"image"
 	"os"
@@ -31,8 +32,29 @@ var (
 )
 
 func main() {
+	recordPath := flag.String("record", "", "record the game to a replay file")
+	replayPath := flag.String("replay", "", "play back a replay file instead of starting a game")
+	flag.Parse()
+
 	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelWarn, true)))
 
+	blockTime := 1000 * time.Millisecond
+
+	if *replayPath != "" {
+		f, err := os.Open(*replayPath)
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		hl, err := core.NewReplayClient(f, blockTime)
+		if err != nil {
+			panic(err)
+		}
+		hl.SetPlayerId(1)
+		runClient(hl)
+		return
+	}
+
 	// Create schemas from codegen
 	schemas := arch.ArchSchemas{Actions: archmod.ActionSchemas, Tables: archmod.TableSchemas}
 
@@ -51,9 +73,9 @@ func main() {
 
 	// Create local simulated io
 	io, err := deploy.NewLocalIO(registry, schemas, func(auth *bind.TransactOpts, ethcli bind.ContractBackend) (addr common.Address, tx *types.Transaction, game deploy.InitializableProxyAdmin, err error) {
-		auth.GasLimit = 3_500_000
+		auth.GasLimit = 5_000_000
 		return game_contract.DeployContract(auth, ethcli)
-	}, pcAddr, data, 1000*time.Millisecond)
+	}, pcAddr, data, blockTime)
 	if err != nil {
 		panic(err)
 	}
@@ -67,12 +89,29 @@ func main() {
 
 	// Create and start client
 	kv := kvstore.NewMemoryKeyValueStore()
-	hl := core.NewHeadlessClient(kv, io)
+	var recorder *rts.ActionRecorder
+	if *recordPath != "" {
+		f, err := os.Create(*recordPath)
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		if recorder, err = rts.NewActionRecorder(f, rts.DefaultTicksPerBlock); err != nil {
+			panic(err)
+		}
+		defer recorder.Close()
+	}
+	// The local io syncs from the genesis block
+	hl := core.NewHeadlessClient(kv, io, blockTime, 0, rts.DefaultTicksPerBlock, recorder)
 	hl.SetPlayerId(1)
 
 	// Start game
 	hl.Start()
 
+	runClient(hl)
+}
+
+func runClient(hl core.IHeadlessClient) {
 	c := game.NewClient(hl, core.ClientConfig{
 		ScreenSize: image.Point{700, 500},
 	}, true)
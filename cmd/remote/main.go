@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"image"
 	"math/big"
@@ -16,6 +17,7 @@ import (
 	game_contract "github.com/concrete-eth/ark-royale/gogen/abigen/game"
 	factory_contract "github.com/concrete-eth/ark-royale/gogen/abigen/game_factory"
 	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/concrete-eth/ark-royale/rts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -56,6 +58,9 @@ func waitForTx(ethcli *ethclient.Client, tx *types.Transaction) {
 }
 
 func main() {
+	recordPath := flag.String("record", "", "record the game to a replay file")
+	flag.Parse()
+
 	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelDebug, true)))
 
 	// Create eth client
@@ -154,8 +159,21 @@ func main() {
 
 	// Create and start client
 	kv := kvstore.NewMemoryKeyValueStore()
-	hl := core.NewHeadlessClient(kv, io)
+	var recorder *rts.ActionRecorder
+	if *recordPath != "" {
+		f, err := os.Create(*recordPath)
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		if recorder, err = rts.NewActionRecorder(f, rts.DefaultTicksPerBlock); err != nil {
+			panic(err)
+		}
+		defer recorder.Close()
+	}
+	hl := core.NewHeadlessClient(kv, io, blockTime, blockNum, rts.DefaultTicksPerBlock, recorder)
 	hl.SetPlayerId(1)
+	hl.EnableStateVerification(ethcli, gameAddr)
 
 	lastBlockNum, err := ethcli.BlockNumber(context.Background())
 	if err != nil {
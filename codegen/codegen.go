@@ -42,6 +42,7 @@ type Object struct {
 
 type Building struct {
 	Object
+	Reserve uint16 // Resources in a mine, or zero for an unlimited mine
 }
 
 type Unit struct {
@@ -49,6 +50,11 @@ type Unit struct {
 	Command uint64
 }
 
+type Terrain struct {
+	Position Point
+	Type     rts.TerrainType
+}
+
 type Player struct {
 	Id         uint8
 	Buildings  []*Building
@@ -83,6 +89,25 @@ func getLayerByPrefix(m *tiled.Map, prefix string) *tiled.Layer {
 	return nil
 }
 
+// Returns the terrain type set in the "Terrain" property of the tile in its tileset. Tiles without
+// the property are ground.
+func getTerrainType(tile *tiled.LayerTile) rts.TerrainType {
+	tilesetTile, err := tile.Tileset.GetTilesetTile(tile.ID)
+	if err != nil {
+		return rts.TerrainType_Ground
+	}
+	name := strings.ToLower(tilesetTile.Properties.GetString("Terrain"))
+	if name == "" {
+		return rts.TerrainType_Ground
+	}
+	for terrainType := rts.TerrainType_Ground; terrainType < rts.TerrainType_Count; terrainType++ {
+		if terrainType.String() == name {
+			return terrainType
+		}
+	}
+	panic("invalid terrain type: " + name)
+}
+
 func getObjGroupByPrefix(m *tiled.Map, prefix string) *tiled.ObjectGroup {
 	prefix = strings.ToLower(prefix)
 	for _, group := range m.ObjectGroups {
@@ -119,6 +144,7 @@ func runBoardLibGen(cmd *cobra.Command, args []string) {
 	unitLayer := getLayerByPrefix(m, "Units")
 	spawnAreasGroup := getObjGroupByPrefix(m, "SpawnAreas")
 	workerPortsGroup := getObjGroupByPrefix(m, "WorkerPorts")
+	mineReservesGroup := getObjGroupByPrefix(m, "MineReserves")
 
 	minX, minY := m.Width, m.Height
 	maxX, maxY := 0, 0
@@ -150,6 +176,7 @@ func runBoardLibGen(cmd *cobra.Command, args []string) {
 		Units:     []*Unit{},
 	}
 
+	terrain := make([]*Terrain, 0)
 	for x := minX; x < maxX+1; x++ {
 		for y := minY; y < maxY+1; y++ {
 			tile := terrainLayer.Tiles[x+y*m.Width]
@@ -161,9 +188,18 @@ func runBoardLibGen(cmd *cobra.Command, args []string) {
 						Position:    Point{X: bX, Y: bY},
 					},
 				})
+			} else if terrainType := getTerrainType(tile); terrainType != rts.TerrainType_Ground {
+				terrain = append(terrain, &Terrain{
+					Position: Point{X: bX, Y: bY},
+					Type:     terrainType,
+				})
 			}
 		}
 	}
+	sort.Slice(terrain, func(i, j int) bool {
+		it, jt := terrain[i], terrain[j]
+		return sortFunc(0, it.Position.X, it.Position.Y, 0, jt.Position.X, jt.Position.Y)
+	})
 
 	if buildingLayer != nil {
 		for x := minX; x < maxX+1; x++ {
@@ -362,6 +398,29 @@ func runBoardLibGen(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if mineReservesGroup != nil {
+		for _, obj := range mineReservesGroup.Objects {
+			reserve := obj.Properties.GetInt("Reserve")
+			if reserve <= 0 || reserve > math.MaxUint16 {
+				panic("Reserve must be between 1 and 65535 for MineReserves")
+			}
+			x := int(obj.X) / m.TileWidth
+			y := int(obj.Y) / m.TileHeight
+			position := Point{X: x - minX, Y: y - minY}
+			var match bool
+			for _, building := range players[0].Buildings {
+				if building.PrototypeId == BuildingPrototypeId_Mine && building.Position == position {
+					building.Reserve = uint16(reserve)
+					match = true
+					break
+				}
+			}
+			if !match {
+				panic("MineReserve must be on a mine")
+			}
+		}
+	}
+
 	for _, player := range players {
 		sort.Slice(player.Buildings, func(i, j int) bool {
 			ib, jb := player.Buildings[i], player.Buildings[j]
@@ -386,6 +445,7 @@ func runBoardLibGen(cmd *cobra.Command, args []string) {
 		"Width":   maxX - minX + 1,
 		"Height":  maxY - minY + 1,
 		"Players": players,
+		"Terrain": terrain,
 		"Imports": []string{
 			"./IActions.sol",
 			"./ICore.sol",
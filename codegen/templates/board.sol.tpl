@@ -8,10 +8,20 @@ uint8 constant WIDTH = {{ $.Width }};
 uint8 constant HEIGHT = {{ $.Height }};
 
 library BoardLib {
-    function initCore(ICore proxy, uint16 w, uint16 h) internal {
+    function initCore(
+        ICore proxy,
+        uint16 w,
+        uint16 h,
+        uint32 maxTicks,
+        uint8 ticksPerBlock,
+        uint8 demolitionRefundPercent
+    ) internal {
         ActionData_Initialize memory initializeData;
         initializeData.width = w;
         initializeData.height = h;
+        initializeData.maxTicks = maxTicks;
+        initializeData.ticksPerBlock = ticksPerBlock;
+        initializeData.demolitionRefundPercent = demolitionRefundPercent;
         proxy.initialize(initializeData);
     }
 
@@ -24,6 +34,21 @@ library BoardLib {
         proxy.placeBuilding(placeBuildingData);
     }
 
+    function setTerrain(ICore proxy, uint16 x, uint16 y, uint8 terrain) internal {
+        ActionData_SetTerrain memory setTerrainData;
+        setTerrainData.x = x;
+        setTerrainData.y = y;
+        setTerrainData.terrain = terrain;
+        proxy.setTerrain(setTerrainData);
+    }
+
+    function setMineReserve(ICore proxy, uint8 buildingId, uint16 resourceReserve) internal {
+        ActionData_SetMineReserve memory setMineReserveData;
+        setMineReserveData.buildingId = buildingId;
+        setMineReserveData.resourceReserve = resourceReserve;
+        proxy.setMineReserve(setMineReserveData);
+    }
+
     function addUnit(ICore proxy, uint8 playerId, uint8 unitTypeId, uint16 x, uint16 y) internal {
         ActionData_CreateUnit memory createUnitData;
         createUnitData.playerId = playerId;
@@ -70,15 +95,33 @@ library BoardLib {
     }
 
     function initEnvironment(ICore proxy) internal {
+        {{- range $t := $.Terrain }}
+        setTerrain(proxy, {{ $t.Position.X }}, {{ $t.Position.Y }}, {{ $t.Type.Uint8 }});
+        {{- end }}
         {{- if index $.Players 0 -}}
         {{- range $i, $b := (index $.Players 0).Buildings }}
         addBuilding(proxy, 0, {{ $b.PrototypeId }}, {{ $b.Position.X }}, {{ $b.Position.Y }});
+        {{- if $b.Reserve }}
+        setMineReserve(proxy, {{ add $i 1 }}, {{ $b.Reserve }});
+        {{- end -}}
         {{- end -}}
         {{- end }}
     }
 
-    function initialize(ICore proxy) internal {
-        initCore(proxy, WIDTH, HEIGHT);
+    function initialize(
+        ICore proxy,
+        uint32 maxTicks,
+        uint8 ticksPerBlock,
+        uint8 demolitionRefundPercent
+    ) internal {
+        initCore(
+            proxy,
+            WIDTH,
+            HEIGHT,
+            maxTicks,
+            ticksPerBlock,
+            demolitionRefundPercent
+        );
         initEnvironment(proxy);
     }
 }
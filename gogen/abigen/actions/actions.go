@@ -40,8 +40,10 @@ type ActionDataAddBuildingPrototype struct {
 	MineTime         uint8
 	MaxIntegrity     uint8
 	BuildingTime     uint8
+	VisionRadius     uint8
 	IsArmory         bool
 	IsEnvironment    bool
+	ResourceReserve  uint16
 }
 
 // ActionDataAddPlayer is an auto generated low-level Go binding around an user-defined struct.
@@ -53,24 +55,31 @@ type ActionDataAddPlayer struct {
 	WorkerPortX          uint16
 	WorkerPortY          uint16
 	UnpurgeableUnitCount uint8
+	TeamId               uint8
 }
 
 // ActionDataAddUnitPrototype is an auto generated low-level Go binding around an user-defined struct.
 type ActionDataAddUnitPrototype struct {
-	Layer             uint8
-	ResourceCost      uint16
-	ComputeCost       uint8
-	SpawnTime         uint8
-	MaxIntegrity      uint8
-	LandStrength      uint8
-	HoverStrength     uint8
-	AirStrength       uint8
-	AttackRange       uint8
-	AttackCooldown    uint8
-	IsAssault         bool
-	IsConfrontational bool
-	IsWorker          bool
-	IsPurgeable       bool
+	Layer              uint8
+	ResourceCost       uint16
+	ComputeCost        uint8
+	SpawnTime          uint8
+	MaxIntegrity       uint8
+	LandStrength       uint8
+	HoverStrength      uint8
+	AirStrength        uint8
+	AttackRange        uint8
+	AttackCooldown     uint8
+	VisionRadius       uint8
+	SplashRadius       uint8
+	SplashFalloff      uint8
+	RepairCooldown     uint8
+	RepairIntegrity    uint8
+	RepairResourceCost uint8
+	IsAssault          bool
+	IsConfrontational  bool
+	IsWorker           bool
+	IsPurgeable        bool
 }
 
 // ActionDataAssignUnit is an auto generated low-level Go binding around an user-defined struct.
@@ -82,6 +91,24 @@ type ActionDataAssignUnit struct {
 	CommandMeta  uint8
 }
 
+// ActionDataAssignUnits is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataAssignUnits struct {
+	PlayerId     uint8
+	UnitMask0    uint64
+	UnitMask1    uint64
+	UnitMask2    uint64
+	UnitMask3    uint64
+	Command      uint64
+	CommandExtra uint64
+	CommandMeta  uint8
+}
+
+// ActionDataCancelUnit is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataCancelUnit struct {
+	PlayerId uint8
+	UnitId   uint8
+}
+
 // ActionDataCreateUnit is an auto generated low-level Go binding around an user-defined struct.
 type ActionDataCreateUnit struct {
 	PlayerId uint8
@@ -90,10 +117,20 @@ type ActionDataCreateUnit struct {
 	Y        uint16
 }
 
+// ActionDataDemolishBuilding is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataDemolishBuilding struct {
+	PlayerId   uint8
+	BuildingId uint8
+}
+
 // ActionDataInitialize is an auto generated low-level Go binding around an user-defined struct.
 type ActionDataInitialize struct {
-	Width  uint16
-	Height uint16
+	Width                   uint16
+	Height                  uint16
+	MaxTicks                uint32
+	TicksPerBlock           uint8
+	DemolitionRefundPercent uint8
+	PathFindNodeBudget      uint16
 }
 
 // ActionDataPlaceBuilding is an auto generated low-level Go binding around an user-defined struct.
@@ -104,9 +141,44 @@ type ActionDataPlaceBuilding struct {
 	Y            uint16
 }
 
+// ActionDataRequestPause is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataRequestPause struct {
+	PlayerId uint8
+}
+
+// ActionDataResume is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataResume struct {
+	PlayerId uint8
+}
+
+// ActionDataSetDamage is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataSetDamage struct {
+	AttackerType uint8
+	TargetType   uint8
+	Strength     uint8
+}
+
+// ActionDataSetMineReserve is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataSetMineReserve struct {
+	BuildingId      uint8
+	ResourceReserve uint16
+}
+
+// ActionDataSetTerrain is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataSetTerrain struct {
+	X       uint16
+	Y       uint16
+	Terrain uint8
+}
+
+// ActionDataSurrender is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataSurrender struct {
+	PlayerId uint8
+}
+
 // ContractMetaData contains all meta data concerning the Contract contract.
 var ContractMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"function\",\"name\":\"addBuildingPrototype\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AddBuildingPrototype\",\"components\":[{\"name\":\"width\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"height\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"resourceCapacity\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCapacity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceMine\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"mineTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isArmory\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isEnvironment\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"addPlayer\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AddPlayer\",\"components\":[{\"name\":\"spawnAreaX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaWidth\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnAreaHeight\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"workerPortX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"workerPortY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"unpurgeableUnitCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"addUnitPrototype\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AddUnitPrototype\",\"components\":[{\"name\":\"layer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCost\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackRange\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackCooldown\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isAssault\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isConfrontational\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isWorker\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isPurgeable\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"assignUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AssignUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"command\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandExtra\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandMeta\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_CreateUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_Initialize\",\"components\":[{\"name\":\"width\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"height\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"placeBuilding\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_PlaceBuilding\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"purge\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"start\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"tick\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"ActionExecuted\",\"inputs\":[{\"name\":\"actionId\",\"type\":\"bytes4\",\"indexed\":false,\"internalType\":\"bytes4\"},{\"name\":\"data\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}],\"anonymous\":false}]",
+	ABI: "[{\"type\":\"function\",\"name\":\"addBuildingPrototype\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AddBuildingPrototype\",\"components\":[{\"name\":\"width\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"height\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"resourceCapacity\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCapacity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceMine\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"mineTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"visionRadius\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isArmory\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isEnvironment\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"resourceReserve\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"addPlayer\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AddPlayer\",\"components\":[{\"name\":\"spawnAreaX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaWidth\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnAreaHeight\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"workerPortX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"workerPortY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"unpurgeableUnitCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"teamId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"addUnitPrototype\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AddUnitPrototype\",\"components\":[{\"name\":\"layer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCost\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackRange\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackCooldown\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"visionRadius\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"splashRadius\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"splashFalloff\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"repairCooldown\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"repairIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"repairResourceCost\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isAssault\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isConfrontational\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isWorker\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isPurgeable\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"assignUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AssignUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"command\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandExtra\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandMeta\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"assignUnits\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AssignUnits\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitMask0\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"unitMask1\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"unitMask2\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"unitMask3\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"command\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandExtra\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandMeta\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"cancelUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_CancelUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_CreateUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"demolishBuilding\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_DemolishBuilding\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_Initialize\",\"components\":[{\"name\":\"width\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"height\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"maxTicks\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"ticksPerBlock\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"demolitionRefundPercent\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"pathFindNodeBudget\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"placeBuilding\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_PlaceBuilding\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"purge\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"requestPause\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_RequestPause\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resume\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_Resume\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDamage\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_SetDamage\",\"components\":[{\"name\":\"attackerType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"targetType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"strength\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMineReserve\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_SetMineReserve\",\"components\":[{\"name\":\"buildingId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceReserve\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTerrain\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_SetTerrain\",\"components\":[{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"terrain\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"start\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"surrender\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_Surrender\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"tick\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"ActionExecuted\",\"inputs\":[{\"name\":\"actionId\",\"type\":\"bytes4\",\"indexed\":false,\"internalType\":\"bytes4\"},{\"name\":\"data\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}],\"anonymous\":false}]",
 }
 
 // ContractABI is the input ABI used to generate the binding from.
@@ -255,65 +327,65 @@ func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method
 	return _Contract.Contract.contract.Transact(opts, method, params...)
 }
 
-// AddBuildingPrototype is a paid mutator transaction binding the contract method 0x982d778d.
+// AddBuildingPrototype is a paid mutator transaction binding the contract method 0x6fca1096.
 //
-// Solidity: function addBuildingPrototype((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,bool,bool) action) returns()
+// Solidity: function addBuildingPrototype((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,uint16) action) returns()
 func (_Contract *ContractTransactor) AddBuildingPrototype(opts *bind.TransactOpts, action ActionDataAddBuildingPrototype) (*types.Transaction, error) {
 	return _Contract.contract.Transact(opts, "addBuildingPrototype", action)
 }
 
-// AddBuildingPrototype is a paid mutator transaction binding the contract method 0x982d778d.
+// AddBuildingPrototype is a paid mutator transaction binding the contract method 0x6fca1096.
 //
-// Solidity: function addBuildingPrototype((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,bool,bool) action) returns()
+// Solidity: function addBuildingPrototype((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,uint16) action) returns()
 func (_Contract *ContractSession) AddBuildingPrototype(action ActionDataAddBuildingPrototype) (*types.Transaction, error) {
 	return _Contract.Contract.AddBuildingPrototype(&_Contract.TransactOpts, action)
 }
 
-// AddBuildingPrototype is a paid mutator transaction binding the contract method 0x982d778d.
+// AddBuildingPrototype is a paid mutator transaction binding the contract method 0x6fca1096.
 //
-// Solidity: function addBuildingPrototype((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,bool,bool) action) returns()
+// Solidity: function addBuildingPrototype((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,uint16) action) returns()
 func (_Contract *ContractTransactorSession) AddBuildingPrototype(action ActionDataAddBuildingPrototype) (*types.Transaction, error) {
 	return _Contract.Contract.AddBuildingPrototype(&_Contract.TransactOpts, action)
 }
 
-// AddPlayer is a paid mutator transaction binding the contract method 0xb44ff0c5.
+// AddPlayer is a paid mutator transaction binding the contract method 0x96693706.
 //
-// Solidity: function addPlayer((uint16,uint16,uint8,uint8,uint16,uint16,uint8) action) returns()
+// Solidity: function addPlayer((uint16,uint16,uint8,uint8,uint16,uint16,uint8,uint8) action) returns()
 func (_Contract *ContractTransactor) AddPlayer(opts *bind.TransactOpts, action ActionDataAddPlayer) (*types.Transaction, error) {
 	return _Contract.contract.Transact(opts, "addPlayer", action)
 }
 
-// AddPlayer is a paid mutator transaction binding the contract method 0xb44ff0c5.
+// AddPlayer is a paid mutator transaction binding the contract method 0x96693706.
 //
-// Solidity: function addPlayer((uint16,uint16,uint8,uint8,uint16,uint16,uint8) action) returns()
+// Solidity: function addPlayer((uint16,uint16,uint8,uint8,uint16,uint16,uint8,uint8) action) returns()
 func (_Contract *ContractSession) AddPlayer(action ActionDataAddPlayer) (*types.Transaction, error) {
 	return _Contract.Contract.AddPlayer(&_Contract.TransactOpts, action)
 }
 
-// AddPlayer is a paid mutator transaction binding the contract method 0xb44ff0c5.
+// AddPlayer is a paid mutator transaction binding the contract method 0x96693706.
 //
-// Solidity: function addPlayer((uint16,uint16,uint8,uint8,uint16,uint16,uint8) action) returns()
+// Solidity: function addPlayer((uint16,uint16,uint8,uint8,uint16,uint16,uint8,uint8) action) returns()
 func (_Contract *ContractTransactorSession) AddPlayer(action ActionDataAddPlayer) (*types.Transaction, error) {
 	return _Contract.Contract.AddPlayer(&_Contract.TransactOpts, action)
 }
 
-// AddUnitPrototype is a paid mutator transaction binding the contract method 0xa5e592f4.
+// AddUnitPrototype is a paid mutator transaction binding the contract method 0x999c3497.
 //
-// Solidity: function addUnitPrototype((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool) action) returns()
+// Solidity: function addUnitPrototype((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool) action) returns()
 func (_Contract *ContractTransactor) AddUnitPrototype(opts *bind.TransactOpts, action ActionDataAddUnitPrototype) (*types.Transaction, error) {
 	return _Contract.contract.Transact(opts, "addUnitPrototype", action)
 }
 
-// AddUnitPrototype is a paid mutator transaction binding the contract method 0xa5e592f4.
+// AddUnitPrototype is a paid mutator transaction binding the contract method 0x999c3497.
 //
-// Solidity: function addUnitPrototype((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool) action) returns()
+// Solidity: function addUnitPrototype((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool) action) returns()
 func (_Contract *ContractSession) AddUnitPrototype(action ActionDataAddUnitPrototype) (*types.Transaction, error) {
 	return _Contract.Contract.AddUnitPrototype(&_Contract.TransactOpts, action)
 }
 
-// AddUnitPrototype is a paid mutator transaction binding the contract method 0xa5e592f4.
+// AddUnitPrototype is a paid mutator transaction binding the contract method 0x999c3497.
 //
-// Solidity: function addUnitPrototype((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool) action) returns()
+// Solidity: function addUnitPrototype((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool) action) returns()
 func (_Contract *ContractTransactorSession) AddUnitPrototype(action ActionDataAddUnitPrototype) (*types.Transaction, error) {
 	return _Contract.Contract.AddUnitPrototype(&_Contract.TransactOpts, action)
 }
@@ -339,6 +411,48 @@ func (_Contract *ContractTransactorSession) AssignUnit(action ActionDataAssignUn
 	return _Contract.Contract.AssignUnit(&_Contract.TransactOpts, action)
 }
 
+// AssignUnits is a paid mutator transaction binding the contract method 0x63e21ea7.
+//
+// Solidity: function assignUnits((uint8,uint64,uint64,uint64,uint64,uint64,uint64,uint8) action) returns()
+func (_Contract *ContractTransactor) AssignUnits(opts *bind.TransactOpts, action ActionDataAssignUnits) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "assignUnits", action)
+}
+
+// AssignUnits is a paid mutator transaction binding the contract method 0x63e21ea7.
+//
+// Solidity: function assignUnits((uint8,uint64,uint64,uint64,uint64,uint64,uint64,uint8) action) returns()
+func (_Contract *ContractSession) AssignUnits(action ActionDataAssignUnits) (*types.Transaction, error) {
+	return _Contract.Contract.AssignUnits(&_Contract.TransactOpts, action)
+}
+
+// AssignUnits is a paid mutator transaction binding the contract method 0x63e21ea7.
+//
+// Solidity: function assignUnits((uint8,uint64,uint64,uint64,uint64,uint64,uint64,uint8) action) returns()
+func (_Contract *ContractTransactorSession) AssignUnits(action ActionDataAssignUnits) (*types.Transaction, error) {
+	return _Contract.Contract.AssignUnits(&_Contract.TransactOpts, action)
+}
+
+// CancelUnit is a paid mutator transaction binding the contract method 0xfcfc2341.
+//
+// Solidity: function cancelUnit((uint8,uint8) action) returns()
+func (_Contract *ContractTransactor) CancelUnit(opts *bind.TransactOpts, action ActionDataCancelUnit) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "cancelUnit", action)
+}
+
+// CancelUnit is a paid mutator transaction binding the contract method 0xfcfc2341.
+//
+// Solidity: function cancelUnit((uint8,uint8) action) returns()
+func (_Contract *ContractSession) CancelUnit(action ActionDataCancelUnit) (*types.Transaction, error) {
+	return _Contract.Contract.CancelUnit(&_Contract.TransactOpts, action)
+}
+
+// CancelUnit is a paid mutator transaction binding the contract method 0xfcfc2341.
+//
+// Solidity: function cancelUnit((uint8,uint8) action) returns()
+func (_Contract *ContractTransactorSession) CancelUnit(action ActionDataCancelUnit) (*types.Transaction, error) {
+	return _Contract.Contract.CancelUnit(&_Contract.TransactOpts, action)
+}
+
 // CreateUnit is a paid mutator transaction binding the contract method 0x143ca15f.
 //
 // Solidity: function createUnit((uint8,uint8,uint16,uint16) action) returns()
@@ -360,23 +474,44 @@ func (_Contract *ContractTransactorSession) CreateUnit(action ActionDataCreateUn
 	return _Contract.Contract.CreateUnit(&_Contract.TransactOpts, action)
 }
 
-// Initialize is a paid mutator transaction binding the contract method 0xeaba9837.
+// DemolishBuilding is a paid mutator transaction binding the contract method 0x8bc3817c.
 //
-// Solidity: function initialize((uint16,uint16) action) returns()
+// Solidity: function demolishBuilding((uint8,uint8) action) returns()
+func (_Contract *ContractTransactor) DemolishBuilding(opts *bind.TransactOpts, action ActionDataDemolishBuilding) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "demolishBuilding", action)
+}
+
+// DemolishBuilding is a paid mutator transaction binding the contract method 0x8bc3817c.
+//
+// Solidity: function demolishBuilding((uint8,uint8) action) returns()
+func (_Contract *ContractSession) DemolishBuilding(action ActionDataDemolishBuilding) (*types.Transaction, error) {
+	return _Contract.Contract.DemolishBuilding(&_Contract.TransactOpts, action)
+}
+
+// DemolishBuilding is a paid mutator transaction binding the contract method 0x8bc3817c.
+//
+// Solidity: function demolishBuilding((uint8,uint8) action) returns()
+func (_Contract *ContractTransactorSession) DemolishBuilding(action ActionDataDemolishBuilding) (*types.Transaction, error) {
+	return _Contract.Contract.DemolishBuilding(&_Contract.TransactOpts, action)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xbaf87e7a.
+//
+// Solidity: function initialize((uint16,uint16,uint32,uint8,uint8,uint16) action) returns()
 func (_Contract *ContractTransactor) Initialize(opts *bind.TransactOpts, action ActionDataInitialize) (*types.Transaction, error) {
 	return _Contract.contract.Transact(opts, "initialize", action)
 }
 
-// Initialize is a paid mutator transaction binding the contract method 0xeaba9837.
+// Initialize is a paid mutator transaction binding the contract method 0xbaf87e7a.
 //
-// Solidity: function initialize((uint16,uint16) action) returns()
+// Solidity: function initialize((uint16,uint16,uint32,uint8,uint8,uint16) action) returns()
 func (_Contract *ContractSession) Initialize(action ActionDataInitialize) (*types.Transaction, error) {
 	return _Contract.Contract.Initialize(&_Contract.TransactOpts, action)
 }
 
-// Initialize is a paid mutator transaction binding the contract method 0xeaba9837.
+// Initialize is a paid mutator transaction binding the contract method 0xbaf87e7a.
 //
-// Solidity: function initialize((uint16,uint16) action) returns()
+// Solidity: function initialize((uint16,uint16,uint32,uint8,uint8,uint16) action) returns()
 func (_Contract *ContractTransactorSession) Initialize(action ActionDataInitialize) (*types.Transaction, error) {
 	return _Contract.Contract.Initialize(&_Contract.TransactOpts, action)
 }
@@ -423,6 +558,111 @@ func (_Contract *ContractTransactorSession) Purge() (*types.Transaction, error)
 	return _Contract.Contract.Purge(&_Contract.TransactOpts)
 }
 
+// RequestPause is a paid mutator transaction binding the contract method 0xef28b525.
+//
+// Solidity: function requestPause((uint8) action) returns()
+func (_Contract *ContractTransactor) RequestPause(opts *bind.TransactOpts, action ActionDataRequestPause) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "requestPause", action)
+}
+
+// RequestPause is a paid mutator transaction binding the contract method 0xef28b525.
+//
+// Solidity: function requestPause((uint8) action) returns()
+func (_Contract *ContractSession) RequestPause(action ActionDataRequestPause) (*types.Transaction, error) {
+	return _Contract.Contract.RequestPause(&_Contract.TransactOpts, action)
+}
+
+// RequestPause is a paid mutator transaction binding the contract method 0xef28b525.
+//
+// Solidity: function requestPause((uint8) action) returns()
+func (_Contract *ContractTransactorSession) RequestPause(action ActionDataRequestPause) (*types.Transaction, error) {
+	return _Contract.Contract.RequestPause(&_Contract.TransactOpts, action)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x1cdaebf7.
+//
+// Solidity: function resume((uint8) action) returns()
+func (_Contract *ContractTransactor) Resume(opts *bind.TransactOpts, action ActionDataResume) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "resume", action)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x1cdaebf7.
+//
+// Solidity: function resume((uint8) action) returns()
+func (_Contract *ContractSession) Resume(action ActionDataResume) (*types.Transaction, error) {
+	return _Contract.Contract.Resume(&_Contract.TransactOpts, action)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x1cdaebf7.
+//
+// Solidity: function resume((uint8) action) returns()
+func (_Contract *ContractTransactorSession) Resume(action ActionDataResume) (*types.Transaction, error) {
+	return _Contract.Contract.Resume(&_Contract.TransactOpts, action)
+}
+
+// SetDamage is a paid mutator transaction binding the contract method 0x9e6ae248.
+//
+// Solidity: function setDamage((uint8,uint8,uint8) action) returns()
+func (_Contract *ContractTransactor) SetDamage(opts *bind.TransactOpts, action ActionDataSetDamage) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setDamage", action)
+}
+
+// SetDamage is a paid mutator transaction binding the contract method 0x9e6ae248.
+//
+// Solidity: function setDamage((uint8,uint8,uint8) action) returns()
+func (_Contract *ContractSession) SetDamage(action ActionDataSetDamage) (*types.Transaction, error) {
+	return _Contract.Contract.SetDamage(&_Contract.TransactOpts, action)
+}
+
+// SetDamage is a paid mutator transaction binding the contract method 0x9e6ae248.
+//
+// Solidity: function setDamage((uint8,uint8,uint8) action) returns()
+func (_Contract *ContractTransactorSession) SetDamage(action ActionDataSetDamage) (*types.Transaction, error) {
+	return _Contract.Contract.SetDamage(&_Contract.TransactOpts, action)
+}
+
+// SetMineReserve is a paid mutator transaction binding the contract method 0x98536e12.
+//
+// Solidity: function setMineReserve((uint8,uint16) action) returns()
+func (_Contract *ContractTransactor) SetMineReserve(opts *bind.TransactOpts, action ActionDataSetMineReserve) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setMineReserve", action)
+}
+
+// SetMineReserve is a paid mutator transaction binding the contract method 0x98536e12.
+//
+// Solidity: function setMineReserve((uint8,uint16) action) returns()
+func (_Contract *ContractSession) SetMineReserve(action ActionDataSetMineReserve) (*types.Transaction, error) {
+	return _Contract.Contract.SetMineReserve(&_Contract.TransactOpts, action)
+}
+
+// SetMineReserve is a paid mutator transaction binding the contract method 0x98536e12.
+//
+// Solidity: function setMineReserve((uint8,uint16) action) returns()
+func (_Contract *ContractTransactorSession) SetMineReserve(action ActionDataSetMineReserve) (*types.Transaction, error) {
+	return _Contract.Contract.SetMineReserve(&_Contract.TransactOpts, action)
+}
+
+// SetTerrain is a paid mutator transaction binding the contract method 0x438ca355.
+//
+// Solidity: function setTerrain((uint16,uint16,uint8) action) returns()
+func (_Contract *ContractTransactor) SetTerrain(opts *bind.TransactOpts, action ActionDataSetTerrain) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setTerrain", action)
+}
+
+// SetTerrain is a paid mutator transaction binding the contract method 0x438ca355.
+//
+// Solidity: function setTerrain((uint16,uint16,uint8) action) returns()
+func (_Contract *ContractSession) SetTerrain(action ActionDataSetTerrain) (*types.Transaction, error) {
+	return _Contract.Contract.SetTerrain(&_Contract.TransactOpts, action)
+}
+
+// SetTerrain is a paid mutator transaction binding the contract method 0x438ca355.
+//
+// Solidity: function setTerrain((uint16,uint16,uint8) action) returns()
+func (_Contract *ContractTransactorSession) SetTerrain(action ActionDataSetTerrain) (*types.Transaction, error) {
+	return _Contract.Contract.SetTerrain(&_Contract.TransactOpts, action)
+}
+
 // Start is a paid mutator transaction binding the contract method 0xbe9a6555.
 //
 // Solidity: function start() returns()
@@ -444,6 +684,27 @@ func (_Contract *ContractTransactorSession) Start() (*types.Transaction, error)
 	return _Contract.Contract.Start(&_Contract.TransactOpts)
 }
 
+// Surrender is a paid mutator transaction binding the contract method 0x9fb278a8.
+//
+// Solidity: function surrender((uint8) action) returns()
+func (_Contract *ContractTransactor) Surrender(opts *bind.TransactOpts, action ActionDataSurrender) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "surrender", action)
+}
+
+// Surrender is a paid mutator transaction binding the contract method 0x9fb278a8.
+//
+// Solidity: function surrender((uint8) action) returns()
+func (_Contract *ContractSession) Surrender(action ActionDataSurrender) (*types.Transaction, error) {
+	return _Contract.Contract.Surrender(&_Contract.TransactOpts, action)
+}
+
+// Surrender is a paid mutator transaction binding the contract method 0x9fb278a8.
+//
+// Solidity: function surrender((uint8) action) returns()
+func (_Contract *ContractTransactorSession) Surrender(action ActionDataSurrender) (*types.Transaction, error) {
+	return _Contract.Contract.Surrender(&_Contract.TransactOpts, action)
+}
+
 // Tick is a paid mutator transaction binding the contract method 0x3eaf5d9f.
 //
 // Solidity: function tick() returns()
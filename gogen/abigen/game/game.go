@@ -29,6 +29,24 @@ var (
 	_ = abi.ConvertType
 )
 
+// ActionDataAssignUnits is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataAssignUnits struct {
+	PlayerId     uint8
+	UnitMask0    uint64
+	UnitMask1    uint64
+	UnitMask2    uint64
+	UnitMask3    uint64
+	Command      uint64
+	CommandExtra uint64
+	CommandMeta  uint8
+}
+
+// ActionDataCancelUnit is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataCancelUnit struct {
+	PlayerId uint8
+	UnitId   uint8
+}
+
 // ActionDataCreateUnit is an auto generated low-level Go binding around an user-defined struct.
 type ActionDataCreateUnit struct {
 	PlayerId uint8
@@ -37,10 +55,39 @@ type ActionDataCreateUnit struct {
 	Y        uint16
 }
 
+// ActionDataDemolishBuilding is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataDemolishBuilding struct {
+	PlayerId   uint8
+	BuildingId uint8
+}
+
+// ActionDataPlaceBuilding is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataPlaceBuilding struct {
+	PlayerId     uint8
+	BuildingType uint8
+	X            uint16
+	Y            uint16
+}
+
+// ActionDataRequestPause is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataRequestPause struct {
+	PlayerId uint8
+}
+
+// ActionDataResume is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataResume struct {
+	PlayerId uint8
+}
+
+// ActionDataSurrender is an auto generated low-level Go binding around an user-defined struct.
+type ActionDataSurrender struct {
+	PlayerId uint8
+}
+
 // ContractMetaData contains all meta data concerning the Contract contract.
 var ContractMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"fallback\",\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"archTick\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_CreateUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getPlayerAddress\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPlayerId\",\"inputs\":[{\"name\":\"playerAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_logic\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"lastTickBlockNumber\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proxy\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"start\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"tick\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]}]",
-	Bin: "0x6080604052348015600f57600080fd5b50612a7a8061001f6000396000f3fe608060405234801561001057600080fd5b50600436106100935760003560e01c8063c4ae16a811610066578063c4ae16a8146100c8578063d1f57894146100f2578063e2ce0beb14610105578063ec55688914610130578063ff2801981461014357610093565b8063143ca15f1461009d5780633eaf5d9f146100b0578063b8546a7d146100b8578063be9a6555146100c0575b61009b61015a565b005b61009b6100ab366004611bef565b6101cf565b61009b61024a565b61009b6105da565b61009b6105e4565b6100db6100d6366004611c88565b61063f565b60405160ff90911681526020015b60405180910390f35b61009b610100366004611ca5565b6106ac565b610118610113366004611d4c565b61081e565b6040516001600160a01b0390911681526020016100e9565b600054610118906001600160a01b031681565b61014c60025481565b6040519081526020016100e9565b6000546001600160a01b03166101b75760405162461bcd60e51b815260206004820152601d60248201527f4172636850726f787941646d696e3a2070726f7879206e6f742073657400000060448201526064015b60405180910390fd5b6000546101cc906001600160a01b0316610851565b50565b805160036101de600183611d7f565b60ff16600281106101f1576101f1611d98565b01546001600160a01b0316331461023d5760405162461bcd60e51b815260206004820152601060248201526f23b0b6b29d1037b7363ca83630bcb2b960811b60448201526064016101ae565b61024682610877565b5050565b6000306127105a61025b9190611dae565b60408051600481526024810182526020810180516001600160e01b031663b8546a7d60e01b179052905161028f9190611dc1565b60006040518083038160008787f1925050503d80600081146102cd576040519150601f19603f3d011682016040523d82523d6000602084013e6102d2565b606091505b50509050806102e057600080fd5b6002600154036102ed5750565b60015b60028160ff1611610246576127105a1015610309575050565b600061031482610b89565b6000805460405163eed886d960e01b815260ff841660048201526001602482015292935090916001600160a01b039091169063eed886d99060440160c060405180830381865afa15801561036c573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906103909190611e1f565b6080015190508060ff166000036103a85750506105c8565b600080546040516301473f3960e21b815260ff861660048201526001600160a01b039091169063051cfce49060240161022060405180830381865afa1580156103f5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104199190611ec7565b6101600151905060045b8160ff168160ff16116105c3576127105a101561044257505050505050565b60008054604051623be62d60e11b815260ff8089166004830152841660248201526001600160a01b03909116906277cc5a9060440161016060405180830381865afa158015610495573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104b99190612034565b606081015190915060ff166003146104d157506105b1565b60006104e08260e00151610ba7565b50909150600090508160028111156104fa576104fa612103565b036105ae576040805160a081018252600091810182905260608101829052608081019190915260ff888116825284166020820152610539876001610bdc565b6001600160401b0316604080830191909152600054905163f8613b5960e01b81526001600160a01b039091169063f8613b599061057a908490600401612119565b600060405180830381600087803b15801561059457600080fd5b505af11580156105a8573d6000803e3d6000fd5b50505050505b50505b806105bb8161216b565b915050610423565b505050505b806105d28161216b565b9150506102f0565b6105e2610bf0565b565b6003600001546001600160a01b031633146106375760405162461bcd60e51b815260206004820152601360248201527247616d653a206f6e6c79506c617965724f6e6560681b60448201526064016101ae565b6105e2610d51565b6000805b60028160ff1610156106a357826001600160a01b031660038260ff166002811061066f5761066f611d98565b01546001600160a01b0316036106915761068a81600161218a565b9392505050565b8061069b8161216b565b915050610643565b50600092915050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff1615906001600160401b03166000811580156106f15750825b90506000826001600160401b0316600114801561070d5750303b155b90508115801561071b575080155b156107395760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff19166001178555831561076357845460ff60401b1916600160401b1785555b6000308860405161077390611b31565b6001600160a01b03928316815291166020820152606060408201819052600090820152608001604051809103906000f0801580156107b5573d6000803e3d6000fd5b5090506107c181610dac565b6107ca87610e95565b5060018055831561081557845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50505050505050565b6000600361082d600184611d7f565b60ff166002811061084057610840611d98565b01546001600160a01b031692915050565b60603660008037600080366000855afa3d6000803e808015610872573d6000f35b3d6000fd5b600460ff16816020015160ff16036108dc5760405162461bcd60e51b815260206004820152602260248201527f47616d653a206f6e6c792066696768746572732063616e206265206372656174604482015261195960f21b60648201526084016101ae565b60005460405163143ca15f60e01b81526001600160a01b039091169063143ca15f9061090c9084906004016121a3565b600060405180830381600087803b15801561092657600080fd5b505af115801561093a573d6000803e3d6000fd5b5050505061096f6040805160a08101825260008082526020820181905291810182905260608101829052608081019190915290565b815160ff168082526000546040516301473f3960e21b815260048101929092526001600160a01b03169063051cfce49060240161022060405180830381865afa1580156109c0573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906109e49190611ec7565b610160015160ff16602082015281516000906109ff90610b89565b90506000600360ff16846060015161ffff1610158015610a2b5750600460ff16846060015161ffff1611155b15610a4257610a3b826001610bdc565b9050610b0f565b6000600360ff16856060015161ffff161015610a6057506002610a64565b5060035b60008054604051623be62d60e11b815260ff8087166004830152841660248201526001600160a01b03909116906277cc5a9060440161016060405180830381865afa158015610ab7573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610adb9190612034565b60600151905060041960ff821601610aff57610af8846001610bdc565b9250610b0c565b610b098483610edd565b92505b50505b6001600160401b038116604080850191909152600054905163f8613b5960e01b81526001600160a01b039091169063f8613b5990610b51908690600401612119565b600060405180830381600087803b158015610b6b57600080fd5b505af1158015610b7f573d6000803e3d6000fd5b5050505050505050565b6000610b966002836121e2565b610ba190600161218a565b92915050565b600080808060ff602086901c166002811115610bc557610bc5612103565b95601086901c65ffffffffffff1695945092505050565b600061068a60018460ff168460ff16610eed565b6002544311610c325760405162461bcd60e51b815260206004820152600e60248201526d185b1c9958591e481d1a58dad95960921b60448201526064016101ae565b600260015403610c9b5760008054604080516370f0c35160e01b815290516001600160a01b03909216926370f0c3519260048084019382900301818387803b158015610c7d57600080fd5b505af1158015610c91573d6000803e3d6000fd5b5050600180555050565b600080546001600160a01b03166127105a610cb69190611dae565b60408051600481526024810182526020810180516001600160e01b0316633eaf5d9f60e01b1790529051610cea9190611dc1565b60006040518083038160008787f1925050503d8060008114610d28576040519150601f19603f3d011682016040523d82523d6000602084013e610d2d565b606091505b505090508015610d3a5750565b6175305a1015610d4c57600260015550565b600080fd5b600080546040805163be9a655560e01b815290516001600160a01b039092169263be9a65559260048084019382900301818387803b158015610d9257600080fd5b505af1158015610da6573d6000803e3d6000fd5b50505050565b6001600160a01b038116610e105760405162461bcd60e51b815260206004820152602560248201527f4172636850726f787941646d696e3a20696e76616c69642070726f7879206164604482015264647265737360d81b60648201526084016101ae565b6000546001600160a01b031615610e735760405162461bcd60e51b815260206004820152602160248201527f4172636850726f787941646d696e3a2070726f787920616c72656164792073656044820152601d60fa1b60648201526084016101ae565b600080546001600160a01b0319166001600160a01b0392909216919091179055565b600054610eaa906001600160a01b0316610f2e565b600054610ebf906001600160a01b03166113de565b600054610ed4906001600160a01b03166115c3565b6101cc816115d9565b600061068a60028460ff168460ff165b6000806020856002811115610f0457610f04612103565b6001600160401b0316901b1760109390931b63ffff0000169290921761ffff919091161792915050565b806001600160a01b031663a5e592f4604051806101c0016040528060006003811115610f5c57610f5c612103565b60ff168152609660208201526001604080830182905260046060840181905260646080850152600560a0850152600a60c0850152600f60e080860191909152610100850182905260036101208601526000610140860181905261016086018590526101808601526101a090940192909252519184901b6001600160e01b0319168252610fe9929101612212565b600060405180830381600087803b15801561100357600080fd5b505af1158015611017573d6000803e3d6000fd5b50505050806001600160a01b031663a5e592f4604051806101c001604052806002600381111561104957611049612103565b60ff168152606460208201526001604080830182905260026060840181905260196080850152600560a0850152600060c08501819052600360e0808701919091526101008601839052610120860192909252610140850184905261016085018490526101808501526101a090930191909152519083901b6001600160e01b03191681526110d99190600401612212565b600060405180830381600087803b1580156110f357600080fd5b505af1158015611107573d6000803e3d6000fd5b50505050806001600160a01b031663a5e592f4604051806101c001604052806000600381111561113957611139612103565b60ff16815261012c6020820152600160408083018290526008606084015260966080840152600a60a0840152600060c08401819052600360e080860182905261010086019190915260046101208601819052610140860183905261016086018390526101808601929092526101a090940192909252519184901b6001600160e01b03191682526111ca929101612212565b600060405180830381600087803b1580156111e457600080fd5b505af11580156111f8573d6000803e3d6000fd5b50505050806001600160a01b031663a5e592f4604051806101c001604052806001600381111561122a5761122a612103565b60ff16815260006020820181905260408083018290526060830182905260016080840181905260a0840183905260c0840183905260e080850184905261010085018490526101208501849052610140850193909352610160840181905261018084018190526101a09093019290925290519083901b6001600160e01b03191681526112b89190600401612212565b600060405180830381600087803b1580156112d257600080fd5b505af11580156112e6573d6000803e3d6000fd5b50505050806001600160a01b031663a5e592f4604051806101c001604052806000600381111561131857611318612103565b60ff16815261012c6020820152600060408083018290526008606084015260966080840152600360a0840181905260c0840183905260e080850182905261010085019190915260016101208501819052610140850184905261016085018190526101808501939093526101a090930191909152519083901b6001600160e01b03191681526113a99190600401612212565b600060405180830381600087803b1580156113c357600080fd5b505af11580156113d7573d6000803e3d6000fd5b5050505050565b604080516101608101825260028082526020820152600081830181905261012c60608301526008608083015260a0820181905260c0820181905260fa60e083015261010082018190526101208201819052610140820152905163982d778d60e01b81526001600160a01b0383169163982d778d9161145f9190600401612311565b600060405180830381600087803b15801561147957600080fd5b505af115801561148d573d6000803e3d6000fd5b505060408051610160810182526001808252602082018190526000828401819052606083018190526080830181905260a0830181905260c0830181905260e083018190526101008301819052610120830152610140820152905163982d778d60e01b81526001600160a01b038516935063982d778d92506115119190600401612311565b600060405180830381600087803b15801561152b57600080fd5b505af115801561153f573d6000803e3d6000fd5b5050604080516101608101825260018082526020820181905260008284018190526060830181905260808301819052601960a084015260c0830181905260e083018190526101008301819052610120830152610140820152905163982d778d60e01b81526001600160a01b038516935063982d778d92506113a99190600401612311565b6115d081600f600861168b565b6101cc816116dd565b6000818060200190518101906115ef91906123e0565b905060005b81518160ff16101561168657600054611622906001600160a01b031661161b83600161218a565b6003611764565b818160ff168151811061163757611637611d98565b602002602001015160038260ff166002811061165557611655611d98565b0180546001600160a01b0319166001600160a01b03929092169190911790558061167e8161216b565b9150506115f4565b505050565b60408051808201825261ffff848116825283811660208301908152925163eaba983760e01b81528251821660048201529251166024830152906001600160a01b0385169063eaba983790604401610b51565b6116ee8160006002600760006119b7565b6116ff8160006002600760026119b7565b6117108160006002600760036119b7565b6117218160006002600760046119b7565b6117328160006002600760056119b7565b61174281600060026007806119b7565b6117538160006003600060026119b7565b6101cc8160006003600e60026119b7565b6040805160e081018252600080825260208201819052918101829052606081018290526080810182905260a081019190915260ff82811660c083015283166001036118ad57600281526000602082018190526005604080840191909152600860608401526080830191909152600360a08301525163b44ff0c560e01b81526001600160a01b0385169063b44ff0c590611801908490600401612491565b600060405180830381600087803b15801561181b57600080fd5b505af115801561182f573d6000803e3d6000fd5b5050505061184384600180600060036119b7565b611854846001600460026003611a45565b6118648460018062010007611a99565b611875846001600560026001611a45565b611886846001600262020001611a99565b611897846001600560026006611a45565b6118a8846001600362020006611a99565b610da6565b8260ff16600203610d4c5760088082526000602083015260056040808401919091526060830191909152600e6080830152600360a08301525163b44ff0c560e01b81526001600160a01b0385169063b44ff0c59061190f908490600401612491565b600060405180830381600087803b15801561192957600080fd5b505af115801561193d573d6000803e3d6000fd5b505050506119528460026001600d60036119b7565b6119638460026004600c6003611a45565b611974846002600162010008611a99565b6119858460026005600c6001611a45565b61199584600280620c0001611a99565b6119a68460026005600c6006611a45565b6118a88460026003620c0006611a99565b6040805160808101825260ff86811682528516602082015261ffff8481168284015283166060820152905163d74de07560e01b81526001600160a01b0387169063d74de07590611a0b9084906004016121a3565b600060405180830381600087803b158015611a2557600080fd5b505af1158015611a39573d6000803e3d6000fd5b50505050505050505050565b6040805160808101825260ff86811682528516602082015261ffff8481168284015283166060820152905163143ca15f60e01b81526001600160a01b0387169063143ca15f90611a0b9084906004016121a3565b6040805160a081018252600060608201819052608082015260ff8581168252841660208201526001600160401b03831681830152905163f8613b5960e01b81526001600160a01b0386169063f8613b5990611af8908490600401612119565b600060405180830381600087803b158015611b1257600080fd5b505af1158015611b26573d6000803e3d6000fd5b505050505050505050565b610550806124f583390190565b634e487b7160e01b600052604160045260246000fd5b60405161022081016001600160401b0381118282101715611b7757611b77611b3e565b60405290565b60405161016081016001600160401b0381118282101715611b7757611b77611b3e565b604051601f8201601f191681016001600160401b0381118282101715611bc857611bc8611b3e565b604052919050565b60ff811681146101cc57600080fd5b61ffff811681146101cc57600080fd5b600060808284031215611c0157600080fd5b604051608081018181106001600160401b0382111715611c2357611c23611b3e565b6040528235611c3181611bd0565b81526020830135611c4181611bd0565b60208201526040830135611c5481611bdf565b60408201526060830135611c6781611bdf565b60608201529392505050565b6001600160a01b03811681146101cc57600080fd5b600060208284031215611c9a57600080fd5b813561068a81611c73565b60008060408385031215611cb857600080fd5b8235611cc381611c73565b91506020838101356001600160401b0380821115611ce057600080fd5b818601915086601f830112611cf457600080fd5b813581811115611d0657611d06611b3e565b611d18601f8201601f19168501611ba0565b91508082528784828501011115611d2e57600080fd5b80848401858401376000848284010152508093505050509250929050565b600060208284031215611d5e57600080fd5b813561068a81611bd0565b634e487b7160e01b600052601160045260246000fd5b60ff8281168282160390811115610ba157610ba1611d69565b634e487b7160e01b600052603260045260246000fd5b81810381811115610ba157610ba1611d69565b6000825160005b81811015611de25760208186018101518583015201611dc8565b506000920191825250919050565b8051611dfb81611bdf565b919050565b8051611dfb81611bd0565b805163ffffffff81168114611dfb57600080fd5b600060c08284031215611e3157600080fd5b60405160c081018181106001600160401b0382111715611e5357611e53611b3e565b6040528251611e6181611bdf565b81526020830151611e7181611bdf565b60208201526040830151611e8481611bd0565b60408201526060830151611e9781611bd0565b60608201526080830151611eaa81611bd0565b6080820152611ebb60a08401611e0b565b60a08201529392505050565b60006102208284031215611eda57600080fd5b611ee2611b54565b611eeb83611df0565b8152611ef960208401611df0565b6020820152611f0a60408401611e00565b6040820152611f1b60608401611e00565b6060820152611f2c60808401611df0565b6080820152611f3d60a08401611df0565b60a0820152611f4e60c08401611df0565b60c0820152611f5f60e08401611df0565b60e0820152610100611f72818501611e00565b90820152610120611f84848201611e00565b90820152610140611f96848201611e00565b90820152610160611fa8848201611e00565b90820152610180611fba848201611e00565b908201526101a0611fcc848201611e00565b908201526101c0611fde848201611e00565b908201526101e0611ff0848201611e00565b90820152610200612002848201611e00565b908201529392505050565b80516001600160401b0381168114611dfb57600080fd5b80518015158114611dfb57600080fd5b6000610160828403121561204757600080fd5b61204f611b7d565b61205883611df0565b815261206660208401611df0565b602082015261207760408401611e00565b604082015261208860608401611e00565b606082015261209960808401611e00565b60808201526120aa60a08401611e00565b60a08201526120bb60c08401611e0b565b60c08201526120cc60e0840161200d565b60e08201526101006120df81850161200d565b908201526101206120f1848201611e00565b90820152610140612002848201612024565b634e487b7160e01b600052602160045260246000fd5b600060a08201905060ff835116825260ff602084015116602083015260408301516001600160401b038082166040850152806060860151166060850152505060ff608084015116608083015292915050565b600060ff821660ff810361218157612181611d69565b60010192915050565b60ff8181168382160190811115610ba157610ba1611d69565b60808101610ba1828460ff815116825260ff6020820151166020830152604081015161ffff808216604085015280606084015116606085015250505050565b600060ff83168061220357634e487b7160e01b600052601260045260246000fd5b8060ff84160691505092915050565b815160ff1681526101c081016020830151612233602084018261ffff169052565b506040830151612248604084018260ff169052565b50606083015161225d606084018260ff169052565b506080830151612272608084018260ff169052565b5060a083015161228760a084018260ff169052565b5060c083015161229c60c084018260ff169052565b5060e08301516122b160e084018260ff169052565b506101008381015160ff9081169184019190915261012080850151909116908301526101408084015115159083015261016080840151151590830152610180808401511515908301526101a080840151801515828501525b505092915050565b815160ff16815261016081016020830151612331602084018260ff169052565b506040830151612347604084018261ffff169052565b50606083015161235d606084018261ffff169052565b506080830151612372608084018260ff169052565b5060a083015161238760a084018260ff169052565b5060c083015161239c60c084018260ff169052565b5060e08301516123b160e084018260ff169052565b506101008381015160ff1690830152610120808401511515908301526101408084015180151582850152612309565b600060208083850312156123f357600080fd5b82516001600160401b038082111561240a57600080fd5b818501915085601f83011261241e57600080fd5b81518181111561243057612430611b3e565b8060051b9150612441848301611ba0565b818152918301840191848101908884111561245b57600080fd5b938501935b83851015612485578451925061247583611c73565b8282529385019390850190612460565b98975050505050505050565b600060e08201905061ffff80845116835280602085015116602084015260ff604085015116604084015260ff60608501511660608401528060808501511660808401528060a08501511660a08401525060ff60c08401511660c08301529291505056fe60806040526040516105503803806105508339810160408190526100229161030d565b818161002e8282610042565b5061003a9050836100a1565b5050506103f9565b61004b8261010f565b6040516001600160a01b038316907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a2805115610095576100908282610153565b505050565b61009d6101ca565b5050565b7f7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f6100e1600080516020610530833981519152546001600160a01b031690565b604080516001600160a01b03928316815291841660208301520160405180910390a161010c816101eb565b50565b807f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc5b80546001600160a01b0319166001600160a01b039290921691909117905550565b6060600080846001600160a01b03168460405161017091906103dd565b600060405180830381855af49150503d80600081146101ab576040519150601f19603f3d011682016040523d82523d6000602084013e6101b0565b606091505b5090925090506101c185838361022f565b95945050505050565b34156101e95760405163b398979f60e01b815260040160405180910390fd5b565b6001600160a01b03811661021a57604051633173bdd160e11b8152600060048201526024015b60405180910390fd5b80600080516020610530833981519152610132565b6060826102445761023f8261028e565b610287565b815115801561025b57506001600160a01b0384163b155b1561028457604051639996b31560e01b81526001600160a01b0385166004820152602401610211565b50805b9392505050565b80511561029e5780518082602001fd5b604051630a12f52160e11b815260040160405180910390fd5b80516001600160a01b03811681146102ce57600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156103045781810151838201526020016102ec565b50506000910152565b60008060006060848603121561032257600080fd5b61032b846102b7565b9250610339602085016102b7565b60408501519092506001600160401b038082111561035657600080fd5b818601915086601f83011261036a57600080fd5b81518181111561037c5761037c6102d3565b604051601f8201601f19908116603f011681019083821181831017156103a4576103a46102d3565b816040528281528960208487010111156103bd57600080fd5b6103ce8360208301602088016102e9565b80955050505050509250925092565b600082516103ef8184602087016102e9565b9190910192915050565b610128806104086000396000f3fe608060405233301480602757506012603a565b6001600160a01b0316336001600160a01b0316145b156033576031606d565b005b603130607b565b60007fb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d61035b546001600160a01b0316919050565b6079607560a0565b60ad565b565b60603660008037600080366000855afa3d6000803e808015609b573d6000f35b3d6000fd5b600060a860cb565b905090565b3660008037600080366000845af43d6000803e808015609b573d6000f35b60007f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc605e56fea264697066735822122057dec10b14e1b5a2675fb25a09f631b5267e5914398ef99beeb1950e80f3910b64736f6c63430008190033b53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103a26469706673582212204f1db9b77653f82a74d3445bdba50f7c967292836d6bd01576811ff2451cd30164736f6c63430008190033",
+	ABI: "[{\"type\":\"fallback\",\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"archTick\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"assignUnits\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_AssignUnits\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitMask0\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"unitMask1\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"unitMask2\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"unitMask3\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"command\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandExtra\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandMeta\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"cancelUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_CancelUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createUnit\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_CreateUnit\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"demolishBuilding\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_DemolishBuilding\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getPlayerAddress\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPlayerId\",\"inputs\":[{\"name\":\"playerAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_logic\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"lastTickBlockNumber\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"placeBuilding\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_PlaceBuilding\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"proxy\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"requestPause\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_RequestPause\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resume\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_Resume\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"start\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"stateHash\",\"inputs\":[],\"outputs\":[{\"name\":\"hash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"surrender\",\"inputs\":[{\"name\":\"action\",\"type\":\"tuple\",\"internalType\":\"structActionData_Surrender\",\"components\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"tick\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]}]",
+	Bin: "0x6080604052348015600f57600080fd5b5061458d8061001f6000396000f3fe608060405234801561001057600080fd5b506004361061010b5760003560e01c8063be9a6555116100a2578063e2ce0beb11610071578063e2ce0beb146101f2578063ec5568891461021d578063ef28b52514610230578063fcfc234114610243578063ff280198146102565761010b565b8063be9a65551461019f578063c4ae16a8146101a7578063d1f57894146101cc578063d74de075146101df5761010b565b8063701da98e116100de578063701da98e146101565780638bc3817c146101715780639fb278a814610184578063b8546a7d146101975761010b565b8063143ca15f146101155780631cdaebf7146101285780633eaf5d9f1461013b57806363e21ea714610143575b61011361025f565b005b610113610123366004612c96565b6102d4565b610113610136366004612cff565b61032c565b610113610380565b610113610151366004612d3b565b610797565b61015e6107eb565b6040519081526020015b60405180910390f35b61011361017f366004612e45565b610d5f565b610113610192366004612cff565b610db3565b610113610e7d565b610113610e87565b6101ba6101b5366004612e76565b610ee2565b60405160ff9091168152602001610168565b6101136101da366004612e93565b610f4f565b6101136101ed366004612c96565b6110c1565b610205610200366004612f3e565b611115565b6040516001600160a01b039091168152602001610168565b600054610205906001600160a01b031681565b61011361023e366004612cff565b611148565b610113610251366004612e45565b61119c565b61015e60025481565b6000546001600160a01b03166102bc5760405162461bcd60e51b815260206004820152601d60248201527f4172636850726f787941646d696e3a2070726f7879206e6f742073657400000060448201526064015b60405180910390fd5b6000546102d1906001600160a01b03166111f0565b50565b805160036102e3600183612f71565b60ff16600281106102f6576102f6612f8a565b01546001600160a01b0316331461031f5760405162461bcd60e51b81526004016102b390612fa0565b61032882611216565b5050565b8051600361033b600183612f71565b60ff166002811061034e5761034e612f8a565b01546001600160a01b031633146103775760405162461bcd60e51b81526004016102b390612fa0565b61032882611528565b6000306127105a6103919190612fca565b60408051600481526024810182526020810180516001600160e01b031663b8546a7d60e01b17905290516103c59190612fdd565b60006040518083038160008787f1925050503d8060008114610403576040519150601f19603f3d011682016040523d82523d6000602084013e610408565b606091505b505090508061041657600080fd5b6002600154036104235750565b60008054906101000a90046001600160a01b03166001600160a01b031663422f7e1d6040518163ffffffff1660e01b815260040161026060405180830381865afa158015610475573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104999190613046565b6101200151156104a65750565b60015b60028160ff1611610328576127105a10156104c2575050565b60006104cd8261158d565b6000805460405163eed886d960e01b815260ff841660048201526001602482015292935090916001600160a01b039091169063eed886d99060440160e060405180830381865afa158015610525573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061054991906131bd565b6080015190508060ff16600003610561575050610785565b600080546040516301473f3960e21b815260ff861660048201526001600160a01b039091169063051cfce4906024016102a060405180830381865afa1580156105ae573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105d29190613259565b6101600151905060045b8160ff168161ffff1611610780576127105a10156105fc57505050505050565b60008054604051623be62d60e11b815260ff8089166004830152841660248201528392916001600160a01b0316906277cc5a9060440161016060405180830381865afa158015610650573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106749190613401565b606081015190915060ff1660031461068d57505061076e565b600061069c8260e001516115ab565b50909150600090508160048111156106b6576106b66134e0565b0361076a576040805160a081018252600091810182905260608101829052608081019190915260ff8981168252841660208201526106f58860016115e0565b6001600160401b0316604080830191909152600054905163f8613b5960e01b81526001600160a01b039091169063f8613b59906107369084906004016134f6565b600060405180830381600087803b15801561075057600080fd5b505af1158015610764573d6000803e3d6000fd5b50505050505b5050505b806107788161354b565b9150506105dc565b505050505b8061078f8161356c565b9150506104a9565b805160036107a6600183612f71565b60ff16600281106107b9576107b9612f8a565b01546001600160a01b031633146107e25760405162461bcd60e51b81526004016102b390612fa0565b610328826115f4565b60008060008054906101000a90046001600160a01b031690506000816001600160a01b031663422f7e1d6040518163ffffffff1660e01b815260040161026060405180830381865afa158015610845573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108699190613046565b9050828160405160200161087e929190613582565b6040516020818303038152906040528051906020012092506000816040015160ff16905060008160016108b191906136cf565b61ffff166001600160401b038111156108cc576108cc612ae5565b6040519080825280602002602001820160405280156108f5578160200160208202803683370190505b50905060006109058360016136cf565b61ffff166001600160401b0381111561092057610920612ae5565b604051908082528060200260200182016040528015610949578160200160208202803683370190505b50905060005b8361ffff168161ffff1611610a6b576040516301473f3960e21b815260ff821660048201526000906001600160a01b0388169063051cfce4906024016102a060405180830381865afa1580156109a9573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906109cd9190613259565b905087816040516020016109e29291906136e9565b604051602081830303815290604052805190602001209750806101600151848361ffff1681518110610a1657610a16612f8a565b602002602001019060ff16908160ff1681525050806101a00151838361ffff1681518110610a4657610a46612f8a565b60ff909216602092830291909101909101525080610a638161354b565b91505061094f565b5060005b846000015161ffff168161ffff161015610b4d5760005b856020015161ffff168161ffff161015610b4457604051632f5d015760e01b815261ffff80841660048301528216602482015288906001600160a01b03891690632f5d01579060440161010060405180830381865afa158015610aed573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610b11919061384a565b604051602001610b229291906138e6565b60408051601f1981840301815291905280516020909101209750600101610a86565b50600101610a6f565b5060005b8361ffff168161ffff1611610c515760015b838261ffff1681518110610b7957610b79612f8a565b602002602001015160ff168161ffff1611610c3e57604051623be62d60e11b815260ff80841660048301528216602482015288906001600160a01b038916906277cc5a9060440161016060405180830381865afa158015610bde573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610c029190613401565b604051602001610c1392919061396c565b6040516020818303038152906040528051906020012097508080610c369061354b565b915050610b63565b5080610c498161354b565b915050610b51565b5060005b8361ffff168161ffff1611610d565760015b828261ffff1681518110610c7d57610c7d612f8a565b602002602001015160ff168161ffff1611610d435760405163eed886d960e01b815260ff80841660048301528216602482015288906001600160a01b0389169063eed886d99060440160e060405180830381865afa158015610ce3573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610d0791906131bd565b604051602001610d18929190613a2f565b6040516020818303038152906040528051906020012097508080610d3b9061354b565b915050610c67565b5080610d4e8161354b565b915050610c55565b50505050505090565b80516003610d6e600183612f71565b60ff1660028110610d8157610d81612f8a565b01546001600160a01b03163314610daa5760405162461bcd60e51b81526004016102b390612fa0565b61032882611624565b6000610dbe33610ee2565b90508060ff16600003610e135760405162461bcd60e51b815260206004820181905260248201527f47616d653a206f6e6c7920706c61796572732063616e2073757272656e64657260448201526064016102b3565b60ff81811683526000546040516313f64f1560e31b8152845190921660048301526001600160a01b031690639fb278a890602401600060405180830381600087803b158015610e6157600080fd5b505af1158015610e75573d6000803e3d6000fd5b505050505050565b610e85611654565b565b6003600001546001600160a01b03163314610eda5760405162461bcd60e51b815260206004820152601360248201527247616d653a206f6e6c79506c617965724f6e6560681b60448201526064016102b3565b610e856117f1565b6000805b60028160ff161015610f4657826001600160a01b031660038260ff1660028110610f1257610f12612f8a565b01546001600160a01b031603610f3457610f2d816001613aa7565b9392505050565b80610f3e8161356c565b915050610ee6565b50600092915050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff1615906001600160401b0316600081158015610f945750825b90506000826001600160401b03166001148015610fb05750303b155b905081158015610fbe575080155b15610fdc5760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff19166001178555831561100657845460ff60401b1916600160401b1785555b6000308860405161101690612ad8565b6001600160a01b03928316815291166020820152606060408201819052600090820152608001604051809103906000f080158015611058573d6000803e3d6000fd5b5090506110648161184c565b61106d87611935565b506001805583156110b857845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50505050505050565b805160036110d0600183612f71565b60ff16600281106110e3576110e3612f8a565b01546001600160a01b0316331461110c5760405162461bcd60e51b81526004016102b390612fa0565b61032882611984565b60006003611124600184612f71565b60ff166002811061113757611137612f8a565b01546001600160a01b031692915050565b80516003611157600183612f71565b60ff166002811061116a5761116a612f8a565b01546001600160a01b031633146111935760405162461bcd60e51b81526004016102b390612fa0565b61032882611a3f565b805160036111ab600183612f71565b60ff16600281106111be576111be612f8a565b01546001600160a01b031633146111e75760405162461bcd60e51b81526004016102b390612fa0565b61032882611a73565b60603660008037600080366000855afa3d6000803e808015611211573d6000f35b3d6000fd5b600460ff16816020015160ff160361127b5760405162461bcd60e51b815260206004820152602260248201527f47616d653a206f6e6c792066696768746572732063616e206265206372656174604482015261195960f21b60648201526084016102b3565b60005460405163143ca15f60e01b81526001600160a01b039091169063143ca15f906112ab908490600401613ac0565b600060405180830381600087803b1580156112c557600080fd5b505af11580156112d9573d6000803e3d6000fd5b5050505061130e6040805160a08101825260008082526020820181905291810182905260608101829052608081019190915290565b815160ff168082526000546040516301473f3960e21b815260048101929092526001600160a01b03169063051cfce4906024016102a060405180830381865afa15801561135f573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113839190613259565b610180015160ff166020820152815160009061139e9061158d565b90506000600360ff16846060015161ffff16101580156113ca5750600460ff16846060015161ffff1611155b156113e1576113da8260016115e0565b90506114ae565b6000600360ff16856060015161ffff1610156113ff57506002611403565b5060035b60008054604051623be62d60e11b815260ff8087166004830152841660248201526001600160a01b03909116906277cc5a9060440161016060405180830381865afa158015611456573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061147a9190613401565b60600151905060041960ff82160161149e576114978460016115e0565b92506114ab565b6114a88483611aa3565b92505b50505b6001600160401b038116604080850191909152600054905163f8613b5960e01b81526001600160a01b039091169063f8613b59906114f09086906004016134f6565b600060405180830381600087803b15801561150a57600080fd5b505af115801561151e573d6000803e3d6000fd5b5050505050505050565b600054604051631cdaebf760e01b8152825160ff1660048201526001600160a01b0390911690631cdaebf7906024015b600060405180830381600087803b15801561157257600080fd5b505af1158015611586573d6000803e3d6000fd5b5050505050565b600061159a600283613afd565b6115a5906001613aa7565b92915050565b600080808060ff602086901c1660048111156115c9576115c96134e0565b95601086901c65ffffffffffff1695945092505050565b6000610f2d60018460ff168460ff16611ab3565b6000546040516363e21ea760e01b81526001600160a01b03909116906363e21ea790611558908490600401613b2d565b6000546040516322f0e05f60e21b81526001600160a01b0390911690638bc3817c90611558908490600401613bea565b60025443116116965760405162461bcd60e51b815260206004820152600e60248201526d185b1c9958591e481d1a58dad95960921b60448201526064016102b3565b60026001540361173b576000805460408051600481526024810182526020810180516001600160e01b03166370f0c35160e01b17905290516001600160a01b03909216916116e49190612fdd565b6000604051808303816000865af19150503d8060008114611721576040519150601f19603f3d011682016040523d82523d6000602084013e611726565b606091505b505090508061173457600080fd5b5060018055565b600080546001600160a01b03166127105a6117569190612fca565b60408051600481526024810182526020810180516001600160e01b0316633eaf5d9f60e01b179052905161178a9190612fdd565b60006040518083038160008787f1925050503d80600081146117c8576040519150601f19603f3d011682016040523d82523d6000602084013e6117cd565b606091505b5050905080156117da5750565b6175305a10156117ec57600260015550565b600080fd5b600080546040805163be9a655560e01b815290516001600160a01b039092169263be9a65559260048084019382900301818387803b15801561183257600080fd5b505af1158015611846573d6000803e3d6000fd5b50505050565b6001600160a01b0381166118b05760405162461bcd60e51b815260206004820152602560248201527f4172636850726f787941646d696e3a20696e76616c69642070726f7879206164604482015264647265737360d81b60648201526084016102b3565b6000546001600160a01b0316156119135760405162461bcd60e51b815260206004820152602160248201527f4172636850726f787941646d696e3a2070726f787920616c72656164792073656044820152601d60fa1b60648201526084016102b3565b600080546001600160a01b0319166001600160a01b0392909216919091179055565b60005461194a906001600160a01b0316611af4565b60005461195f906001600160a01b0316612065565b60005461197b906001600160a01b0316610708600160326124d6565b6102d1816124ef565b602081015160ff166004148015906119a45750602081015160ff16600514155b80156119b85750602081015160ff16600614155b15611a0f5760405162461bcd60e51b815260206004820152602160248201527f47616d653a206275696c64696e672074797065206e6f74206275696c6461626c6044820152606560f81b60648201526084016102b3565b60005460405163d74de07560e01b81526001600160a01b039091169063d74de07590611558908490600401613ac0565b60005460405163ef28b52560e01b8152825160ff1660048201526001600160a01b039091169063ef28b52590602401611558565b60005460405163fcfc234160e01b81526001600160a01b039091169063fcfc234190611558908490600401613bea565b6000610f2d60028460ff168460ff165b6000806020856004811115611aca57611aca6134e0565b6001600160401b0316901b1760109390931b63ffff0000169290921761ffff919091161792915050565b806001600160a01b031663999c349760405180610280016040528060006003811115611b2257611b226134e0565b60ff168152609660208201526001604080830182905260046060840181905260646080850152600560a08501819052600a60c0860152600f60e080870191909152610100860183905260036101208701526101408601919091526000610160860181905261018086018190526101a086018190526101c086018190526101e086018190526102008601819052610220860185905261024086015261026090940192909252519184901b6001600160e01b0319168252611be2929101613c08565b600060405180830381600087803b158015611bfc57600080fd5b505af1158015611c10573d6000803e3d6000fd5b50505050806001600160a01b031663999c349760405180610280016040528060026003811115611c4257611c426134e0565b60ff168152606460208201526001604080830182905260026060840181905260196080850152600560a0850152600060c08501819052600360e080870191909152610100860183905261012086019290925260046101408601819052610160860182905261018086018290526101a086018290526101c086018290526101e086018290526102008601859052610220860185905261024086019190915261026090940192909252519084901b6001600160e01b0319168152611d05929101613c08565b600060405180830381600087803b158015611d1f57600080fd5b505af1158015611d33573d6000803e3d6000fd5b50505050806001600160a01b031663999c349760405180610280016040528060006003811115611d6557611d656134e0565b60ff16815261012c6020820152600160408083018290526008606084015260966080840152600a60a0840152600060c08401819052600360e0808601829052610100860191909152600461012086018190526101408601819052610160860185905260326101808701526101a086018390526101c086018390526101e086018390526102008601839052610220860183905261024086019290925261026090940192909252519184901b6001600160e01b0319168252611e26929101613c08565b600060405180830381600087803b158015611e4057600080fd5b505af1158015611e54573d6000803e3d6000fd5b50505050806001600160a01b031663999c349760405180610280016040528060016003811115611e8657611e866134e0565b60ff16815260006020820181905260408083018290526060830182905260016080840181905260a0840183905260c0840183905260e08085018490526101008501849052610120850184905260026101408601819052610160860185905261018086018590526101a086015260056101c086018190526101e0860152610200850193909352610220840181905261024084018190526102609093019290925290519083901b6001600160e01b0319168152611f449190600401613c08565b600060405180830381600087803b158015611f5e57600080fd5b505af1158015611f72573d6000803e3d6000fd5b50505050806001600160a01b031663999c349760405180610280016040528060006003811115611fa457611fa46134e0565b60ff16815261012c6020820152600060408083018290526008606084015260966080840152600360a0840181905260c0840183905260e08085018290526101008501919091526001610120850181905260046101408601819052610160860185905261018086018590526101a086018590526101c086018590526101e086018590526102008601859052610220860182905261024086019490945261026090940193909352519184901b6001600160e01b0319168252611558929101613c08565b604080516101a08101825260028082526020820152600081830181905261012c60608301526008608083015260a0820181905260c0820181905260fa60e08301526101008201819052600461012083018190526101408301829052610160830182905261018083019190915291516337e5084b60e11b81526001600160a01b03841692636fca1096926120fa92909101613db7565b600060405180830381600087803b15801561211457600080fd5b505af1158015612128573d6000803e3d6000fd5b5050604080516101a0810182526001808252602082018190526000828401819052606083018190526080830181905260a0830181905260c0830181905260e0830181905261010083018190526101208301819052610140830181905261016083019190915261018082015290516337e5084b60e11b81526001600160a01b0385169350636fca109692506121bf9190600401613db7565b600060405180830381600087803b1580156121d957600080fd5b505af11580156121ed573d6000803e3d6000fd5b5050604080516101a08101825260018082526020820181905260008284018190526060830181905260808301819052601960a084015260c0830181905260e0830181905261010083018190526101208301819052610140830181905261016083019190915261018082015290516337e5084b60e11b81526001600160a01b0385169350636fca109692506122849190600401613db7565b600060405180830381600087803b15801561229e57600080fd5b505af11580156122b2573d6000803e3d6000fd5b5050604080516101a081018252600280825260208201819052606482840181905261012c606084015260006080840181905260a0840181905260c0840181905260e084019190915260086101008401526101208301919091526101408201819052610160820181905261018082015290516337e5084b60e11b81526001600160a01b0385169350636fca1096925061234d9190600401613db7565b600060405180830381600087803b15801561236757600080fd5b505af115801561237b573d6000803e3d6000fd5b5050604080516101a08101825260028082526020820181905260968284015260006060830181905260046080840181905260a0840182905260c08401829052606460e0850152600c6101008501526101208401929092526101408301819052610160830181905261018083015291516337e5084b60e11b81526001600160a01b0386169450636fca109693506124119201613db7565b600060405180830381600087803b15801561242b57600080fd5b505af115801561243f573d6000803e3d6000fd5b5050604080516101a08101825260028082526020820181905260c8828401526000606083018190526080830181905260a0830181905260c08301819052609660e084015260106101008401526101208301919091526001610140830152610160820181905261018082015290516337e5084b60e11b81526001600160a01b0385169350636fca109692506115589190600401613db7565b6124e684600f60088686866125a1565b6118468461267c565b6000818060200190518101906125059190613ec9565b905060005b81518160ff16101561259c57600054612538906001600160a01b0316612531836001613aa7565b6003612703565b818160ff168151811061254d5761254d612f8a565b602002602001015160038260ff166002811061256b5761256b612f8a565b0180546001600160a01b0319166001600160a01b0392909216919091179055806125948161356c565b91505061250a565b505050565b6040805160c081018252600060a0820190815261ffff88811683528781166020840190815263ffffffff88811685870190815260ff89811660608801908152898216608089019081529851635d7c3f3d60e11b81528851871660048201529451861660248601529151909216604484015251811660648301529451909416608485015290511660a4830152906001600160a01b0388169063baf87e7a9060c401600060405180830381600087803b15801561265b57600080fd5b505af115801561266f573d6000803e3d6000fd5b5050505050505050505050565b61268d81600060026007600061295e565b61269e81600060026007600261295e565b6126af81600060026007600361295e565b6126c081600060026007600461295e565b6126d181600060026007600561295e565b6126e1816000600260078061295e565b6126f281600060036000600261295e565b6102d18160006003600e600261295e565b6040805161010081018252600080825260208201819052918101829052606081018290526080810182905260a0810182905260e081019190915260ff82811660c0830152831660010361285457600281526000602082018190526005604080840191909152600860608401526080830191909152600360a083015251634b349b8360e11b81526001600160a01b038516906396693706906127a8908490600401613f80565b600060405180830381600087803b1580156127c257600080fd5b505af11580156127d6573d6000803e3d6000fd5b505050506127ea846001806000600361295e565b6127fb8460016004600260036129ec565b61280b8460018062010007612a40565b61281c8460016005600260016129ec565b61282d846001600262020001612a40565b61283e8460016005600260066129ec565b61284f846001600362020006612a40565b611846565b8260ff166002036117ec5760088082526000602083015260056040808401919091526060830191909152600e6080830152600360a083015251634b349b8360e11b81526001600160a01b038516906396693706906128b6908490600401613f80565b600060405180830381600087803b1580156128d057600080fd5b505af11580156128e4573d6000803e3d6000fd5b505050506128f98460026001600d600361295e565b61290a8460026004600c60036129ec565b61291b846002600162010008612a40565b61292c8460026005600c60016129ec565b61293c84600280620c0001612a40565b61294d8460026005600c60066129ec565b61284f8460026003620c0006612a40565b6040805160808101825260ff86811682528516602082015261ffff8481168284015283166060820152905163d74de07560e01b81526001600160a01b0387169063d74de075906129b2908490600401613ac0565b600060405180830381600087803b1580156129cc57600080fd5b505af11580156129e0573d6000803e3d6000fd5b50505050505050505050565b6040805160808101825260ff86811682528516602082015261ffff8481168284015283166060820152905163143ca15f60e01b81526001600160a01b0387169063143ca15f906129b2908490600401613ac0565b6040805160a081018252600060608201819052608082015260ff8581168252841660208201526001600160401b03831681830152905163f8613b5960e01b81526001600160a01b0386169063f8613b5990612a9f9084906004016134f6565b600060405180830381600087803b158015612ab957600080fd5b505af1158015612acd573d6000803e3d6000fd5b505050505050505050565b6105568061400283390190565b634e487b7160e01b600052604160045260246000fd5b60405161010081016001600160401b0381118282101715612b1e57612b1e612ae5565b60405290565b60405161026081016001600160401b0381118282101715612b1e57612b1e612ae5565b60405160e081016001600160401b0381118282101715612b1e57612b1e612ae5565b6040516102a081016001600160401b0381118282101715612b1e57612b1e612ae5565b60405161016081016001600160401b0381118282101715612b1e57612b1e612ae5565b604051601f8201601f191681016001600160401b0381118282101715612bd757612bd7612ae5565b604052919050565b60ff811681146102d157600080fd5b8035612bf981612bdf565b919050565b61ffff811681146102d157600080fd5b600060808284031215612c2057600080fd5b604051608081016001600160401b0381118282101715612c4257612c42612ae5565b6040529050808235612c5381612bdf565b81526020830135612c6381612bdf565b60208201526040830135612c7681612bfe565b60408201526060830135612c8981612bfe565b6060919091015292915050565b600060808284031215612ca857600080fd5b610f2d8383612c0e565b600060208284031215612cc457600080fd5b604051602081016001600160401b0381118282101715612ce657612ce6612ae5565b6040529050808235612cf781612bdf565b905292915050565b600060208284031215612d1157600080fd5b610f2d8383612cb2565b6001600160401b03811681146102d157600080fd5b8035612bf981612d1b565b6000610100828403128015612d4f57600080fd5b50612d58612afb565b8235612d6381612bdf565b8152612d7160208401612d30565b6020820152612d8260408401612d30565b6040820152612d9360608401612d30565b6060820152612da460808401612d30565b6080820152612db560a08401612d30565b60a0820152612dc660c08401612d30565b60c0820152612dd760e08401612bee565b60e08201529392505050565b600060408284031215612df557600080fd5b604080519081016001600160401b0381118282101715612e1757612e17612ae5565b6040529050808235612e2881612bdf565b81526020830135612e3881612bdf565b6020919091015292915050565b600060408284031215612e5757600080fd5b610f2d8383612de3565b6001600160a01b03811681146102d157600080fd5b600060208284031215612e8857600080fd5b8135610f2d81612e61565b60008060408385031215612ea657600080fd5b8235612eb181612e61565b915060208301356001600160401b03811115612ecc57600080fd5b8301601f81018513612edd57600080fd5b80356001600160401b03811115612ef657612ef6612ae5565b612f09601f8201601f1916602001612baf565b818152866020838501011115612f1e57600080fd5b816020840160208301376000602083830101528093505050509250929050565b600060208284031215612f5057600080fd5b8135610f2d81612bdf565b634e487b7160e01b600052601160045260246000fd5b60ff82811682821603908111156115a5576115a5612f5b565b634e487b7160e01b600052603260045260246000fd5b60208082526010908201526f23b0b6b29d1037b7363ca83630bcb2b960811b604082015260600190565b818103818111156115a5576115a5612f5b565b6000825160005b81811015612ffe5760208186018101518583015201612fe4565b506000920191825250919050565b8051612bf981612bfe565b8051612bf981612bdf565b80518015158114612bf957600080fd5b805163ffffffff81168114612bf957600080fd5b600061026082840312801561305a57600080fd5b50613063612b24565b61306c8361300c565b815261307a6020840161300c565b602082015261308b60408401613017565b604082015261309c60608401613017565b60608201526130ad60808401613017565b60808201526130be60a08401613022565b60a08201526130cf60c08401613022565b60c08201526130e060e08401613032565b60e08201526130f26101008401613032565b6101008201526131056101208401613022565b6101208201526131186101408401613017565b61014082015261312b6101608401613032565b61016082015261313e6101808401613022565b6101808201526131516101a08401613017565b6101a08201526131646101c08401613032565b6101c08201526131776101e08401613032565b6101e082015261318a6102008401613017565b61020082015261319d6102208401613017565b6102208201526131b0610240840161300c565b6102408201529392505050565b600060e08284031280156131d057600080fd5b506131d9612b47565b82516131e481612bfe565b815260208301516131f481612bfe565b6020820152604083015161320781612bdf565b6040820152606083015161321a81612bdf565b606082015261322b60808401613017565b608082015261323c60a08401613032565b60a082015261324d60c0840161300c565b60c08201529392505050565b60006102a082840312801561326d57600080fd5b50613276612b69565b61327f8361300c565b815261328d6020840161300c565b602082015261329e60408401613017565b60408201526132af60608401613017565b60608201526132c06080840161300c565b60808201526132d160a0840161300c565b60a08201526132e260c0840161300c565b60c08201526132f360e0840161300c565b60e08201526133056101008401613017565b6101008201526133186101208401613017565b61012082015261332b6101408401613017565b61014082015261333e6101608401613017565b6101608201526133516101808401613017565b6101808201526133646101a08401613017565b6101a08201526133776101c08401613017565b6101c082015261338a6101e08401613017565b6101e082015261339d6102008401613017565b6102008201526133b06102208401613017565b6102208201526133c36102408401613017565b6102408201526133d66102608401613022565b6102608201526133e96102808401613022565b6102808201529392505050565b8051612bf981612d1b565b600061016082840312801561341557600080fd5b5061341e612b8c565b6134278361300c565b81526134356020840161300c565b602082015261344660408401613017565b604082015261345760608401613017565b606082015261346860808401613017565b608082015261347960a08401613017565b60a082015261348a60c08401613032565b60c082015261349b60e084016133f6565b60e08201526134ad61010084016133f6565b6101008201526134c06101208401613017565b6101208201526134d36101408401613022565b6101408201529392505050565b634e487b7160e01b600052602160045260246000fd5b600060a08201905060ff835116825260ff60208401511660208301526001600160401b0360408401511660408301526001600160401b03606084015116606083015260ff608084015116608083015292915050565b600061ffff821661ffff810361356357613563612f5b565b60010192915050565b600060ff821660ff810361356357613563612f5b565b828152815161ffff1660208201526102808101602083015161ffff8116604084015250604083015160ff8116606084015250606083015160ff8116608084015250608083015160ff811660a08401525060a083015180151560c08401525060c083015180151560e08401525060e083015163ffffffff81166101008401525061010083015163ffffffff8116610120840152506101208301518015156101408401525061014083015160ff81166101608401525061016083015163ffffffff8116610180840152506101808301518015156101a0840152506101a083015160ff81166101c0840152506101c083015163ffffffff81166101e0840152506101e083015163ffffffff81166102008401525061020083015160ff81166102208401525061022083015160ff81166102408401525061024083015161ffff81166102608401525b509392505050565b61ffff81811683821601908111156115a5576115a5612f5b565b828152815161ffff1660208201526102c08101602083015161ffff8116604084015250604083015160ff8116606084015250606083015160ff8116608084015250608083015161ffff811660a08401525060a083015161ffff811660c08401525060c083015161ffff811660e08401525060e083015161ffff81166101008401525061010083015160ff81166101208401525061012083015160ff81166101408401525061014083015160ff81166101608401525061016083015160ff81166101808401525061018083015160ff81166101a0840152506101a083015160ff81166101c0840152506101c083015160ff81166101e0840152506101e083015160ff81166102008401525061020083015160ff81166102208401525061022083015160ff81166102408401525061024083015160ff811661026084015250610260830151801515610280840152506102808301518015156102a08401526136c7565b600061010082840312801561385e57600080fd5b50613867612afb565b825161387281612bdf565b815261388060208401613017565b602082015261389160408401613017565b60408201526138a260608401613017565b60608201526138b360808401613017565b60808201526138c460a08401613017565b60a08201526138d560c08401613017565b60c0820152612dd760e08401613017565b60006101208201905083825260ff835116602083015260ff602084015116604083015260ff6040840151166060830152606083015161392a608084018260ff169052565b50608083015160ff811660a08401525060a083015160ff811660c08401525060c083015160ff811660e08401525060e083015160ff81166101008401526136c7565b828152815161ffff1660208201526101808101602083015161ffff8116604084015250604083015160ff8116606084015250606083015160ff8116608084015250608083015160ff811660a08401525060a083015160ff811660c08401525060c083015163ffffffff811660e08401525060e08301516001600160401b038116610100840152506101008301516001600160401b0381166101208401525061012083015160ff8116610140840152506101408301518015156101608401526136c7565b60006101008201905083825261ffff835116602083015261ffff602084015116604083015260ff604084015116606083015260ff606084015116608083015260ff60808401511660a083015260a0830151613a9260c084018263ffffffff169052565b5060c083015161ffff811660e08401526136c7565b60ff81811683821601908111156115a5576115a5612f5b565b608081016115a5828460ff815116825260ff602082015116602083015261ffff604082015116604083015261ffff60608201511660608301525050565b600060ff831680613b1e57634e487b7160e01b600052601260045260246000fd5b8060ff84160691505092915050565b60006101008201905060ff83511682526001600160401b0360208401511660208301526001600160401b0360408401511660408301526060830151613b7d60608401826001600160401b03169052565b506080830151613b9860808401826001600160401b03169052565b5060a0830151613bb360a08401826001600160401b03169052565b5060c0830151613bce60c08401826001600160401b03169052565b5060e0830151613be360e084018260ff169052565b5092915050565b604081016115a58284805160ff908116835260209182015116910152565b815160ff16815261028081016020830151613c29602084018261ffff169052565b506040830151613c3e604084018260ff169052565b506060830151613c53606084018260ff169052565b506080830151613c68608084018260ff169052565b5060a0830151613c7d60a084018260ff169052565b5060c0830151613c9260c084018260ff169052565b5060e0830151613ca760e084018260ff169052565b50610100830151613cbe61010084018260ff169052565b50610120830151613cd561012084018260ff169052565b50610140830151613cec61014084018260ff169052565b50610160830151613d0361016084018260ff169052565b50610180830151613d1a61018084018260ff169052565b506101a0830151613d316101a084018260ff169052565b506101c0830151613d486101c084018260ff169052565b506101e0830151613d5f6101e084018260ff169052565b50610200830151613d7561020084018215159052565b50610220830151613d8b61022084018215159052565b50610240830151613da161024084018215159052565b50610260830151613be361026084018215159052565b815160ff1681526101a081016020830151613dd7602084018260ff169052565b506040830151613ded604084018261ffff169052565b506060830151613e03606084018261ffff169052565b506080830151613e18608084018260ff169052565b5060a0830151613e2d60a084018260ff169052565b5060c0830151613e4260c084018260ff169052565b5060e0830151613e5760e084018260ff169052565b50610100830151613e6e61010084018260ff169052565b50610120830151613e8561012084018260ff169052565b50610140830151613e9b61014084018215159052565b50610160830151613eb161016084018215159052565b50610180830151613be361018084018261ffff169052565b600060208284031215613edb57600080fd5b81516001600160401b03811115613ef157600080fd5b8201601f81018413613f0257600080fd5b80516001600160401b03811115613f1b57613f1b612ae5565b8060051b613f2b60208201612baf565b91825260208184018101929081019087841115613f4757600080fd5b6020850194505b83851015613f755784519250613f6383612e61565b82825260209485019490910190613f4e565b979650505050505050565b60006101008201905061ffff835116825261ffff602084015116602083015260ff60408401511660408301526060830151613fc0606084018260ff169052565b506080830151613fd6608084018261ffff169052565b5060a0830151613fec60a084018261ffff169052565b5060c0830151613bce60c084018260ff16905256fe60806040526040516105563803806105568339810160408190526100229161030d565b818161002e8282610042565b5061003a9050836100a1565b5050506103ff565b61004b8261010f565b6040516001600160a01b038316907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a2805115610095576100908282610153565b505050565b61009d6101ca565b5050565b7f7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f6100e1600080516020610536833981519152546001600160a01b031690565b604080516001600160a01b03928316815291841660208301520160405180910390a161010c816101eb565b50565b807f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc5b80546001600160a01b0319166001600160a01b039290921691909117905550565b6060600080846001600160a01b03168460405161017091906103e3565b600060405180830381855af49150503d80600081146101ab576040519150601f19603f3d011682016040523d82523d6000602084013e6101b0565b606091505b5090925090506101c185838361022f565b95945050505050565b34156101e95760405163b398979f60e01b815260040160405180910390fd5b565b6001600160a01b03811661021a57604051633173bdd160e11b8152600060048201526024015b60405180910390fd5b80600080516020610536833981519152610132565b6060826102445761023f8261028e565b610287565b815115801561025b57506001600160a01b0384163b155b1561028457604051639996b31560e01b81526001600160a01b0385166004820152602401610211565b50805b9392505050565b80511561029e5780518082602001fd5b604051630a12f52160e11b815260040160405180910390fd5b80516001600160a01b03811681146102ce57600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156103045781810151838201526020016102ec565b50506000910152565b60008060006060848603121561032257600080fd5b61032b846102b7565b9250610339602085016102b7565b60408501519092506001600160401b0381111561035557600080fd5b8401601f8101861361036657600080fd5b80516001600160401b0381111561037f5761037f6102d3565b604051601f8201601f19908116603f011681016001600160401b03811182821017156103ad576103ad6102d3565b6040528181528282016020018810156103c557600080fd5b6103d68260208301602086016102e9565b8093505050509250925092565b600082516103f58184602087016102e9565b9190910192915050565b6101288061040e6000396000f3fe608060405233301480602757506012603a565b6001600160a01b0316336001600160a01b0316145b156033576031606d565b005b603130607b565b60007fb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d61035b546001600160a01b0316919050565b6079607560a0565b60ad565b565b60603660008037600080366000855afa3d6000803e808015609b573d6000f35b3d6000fd5b600060a860cb565b905090565b3660008037600080366000845af43d6000803e808015609b573d6000f35b60007f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc605e56fea26469706673582212203146104e8eb6faa0d3f0bcafadce8e3db338296a6e759b84f00d88ca3c1c7c7d64736f6c634300081e0033b53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103a2646970667358221220fb367ca8710336ee4052c1599ef116caedae954a8c67502af674944752faf2b564736f6c634300081e0033",
 }
 
 // ContractABI is the input ABI used to generate the binding from.
@@ -334,6 +381,37 @@ func (_Contract *ContractCallerSession) Proxy() (common.Address, error) {
 	return _Contract.Contract.Proxy(&_Contract.CallOpts)
 }
 
+// StateHash is a free data retrieval call binding the contract method 0x701da98e.
+//
+// Solidity: function stateHash() view returns(bytes32 hash)
+func (_Contract *ContractCaller) StateHash(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "stateHash")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// StateHash is a free data retrieval call binding the contract method 0x701da98e.
+//
+// Solidity: function stateHash() view returns(bytes32 hash)
+func (_Contract *ContractSession) StateHash() ([32]byte, error) {
+	return _Contract.Contract.StateHash(&_Contract.CallOpts)
+}
+
+// StateHash is a free data retrieval call binding the contract method 0x701da98e.
+//
+// Solidity: function stateHash() view returns(bytes32 hash)
+func (_Contract *ContractCallerSession) StateHash() ([32]byte, error) {
+	return _Contract.Contract.StateHash(&_Contract.CallOpts)
+}
+
 // ArchTick is a paid mutator transaction binding the contract method 0xb8546a7d.
 //
 // Solidity: function archTick() returns()
@@ -355,6 +433,48 @@ func (_Contract *ContractTransactorSession) ArchTick() (*types.Transaction, erro
 	return _Contract.Contract.ArchTick(&_Contract.TransactOpts)
 }
 
+// AssignUnits is a paid mutator transaction binding the contract method 0x63e21ea7.
+//
+// Solidity: function assignUnits((uint8,uint64,uint64,uint64,uint64,uint64,uint64,uint8) action) returns()
+func (_Contract *ContractTransactor) AssignUnits(opts *bind.TransactOpts, action ActionDataAssignUnits) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "assignUnits", action)
+}
+
+// AssignUnits is a paid mutator transaction binding the contract method 0x63e21ea7.
+//
+// Solidity: function assignUnits((uint8,uint64,uint64,uint64,uint64,uint64,uint64,uint8) action) returns()
+func (_Contract *ContractSession) AssignUnits(action ActionDataAssignUnits) (*types.Transaction, error) {
+	return _Contract.Contract.AssignUnits(&_Contract.TransactOpts, action)
+}
+
+// AssignUnits is a paid mutator transaction binding the contract method 0x63e21ea7.
+//
+// Solidity: function assignUnits((uint8,uint64,uint64,uint64,uint64,uint64,uint64,uint8) action) returns()
+func (_Contract *ContractTransactorSession) AssignUnits(action ActionDataAssignUnits) (*types.Transaction, error) {
+	return _Contract.Contract.AssignUnits(&_Contract.TransactOpts, action)
+}
+
+// CancelUnit is a paid mutator transaction binding the contract method 0xfcfc2341.
+//
+// Solidity: function cancelUnit((uint8,uint8) action) returns()
+func (_Contract *ContractTransactor) CancelUnit(opts *bind.TransactOpts, action ActionDataCancelUnit) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "cancelUnit", action)
+}
+
+// CancelUnit is a paid mutator transaction binding the contract method 0xfcfc2341.
+//
+// Solidity: function cancelUnit((uint8,uint8) action) returns()
+func (_Contract *ContractSession) CancelUnit(action ActionDataCancelUnit) (*types.Transaction, error) {
+	return _Contract.Contract.CancelUnit(&_Contract.TransactOpts, action)
+}
+
+// CancelUnit is a paid mutator transaction binding the contract method 0xfcfc2341.
+//
+// Solidity: function cancelUnit((uint8,uint8) action) returns()
+func (_Contract *ContractTransactorSession) CancelUnit(action ActionDataCancelUnit) (*types.Transaction, error) {
+	return _Contract.Contract.CancelUnit(&_Contract.TransactOpts, action)
+}
+
 // CreateUnit is a paid mutator transaction binding the contract method 0x143ca15f.
 //
 // Solidity: function createUnit((uint8,uint8,uint16,uint16) action) returns()
@@ -376,6 +496,27 @@ func (_Contract *ContractTransactorSession) CreateUnit(action ActionDataCreateUn
 	return _Contract.Contract.CreateUnit(&_Contract.TransactOpts, action)
 }
 
+// DemolishBuilding is a paid mutator transaction binding the contract method 0x8bc3817c.
+//
+// Solidity: function demolishBuilding((uint8,uint8) action) returns()
+func (_Contract *ContractTransactor) DemolishBuilding(opts *bind.TransactOpts, action ActionDataDemolishBuilding) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "demolishBuilding", action)
+}
+
+// DemolishBuilding is a paid mutator transaction binding the contract method 0x8bc3817c.
+//
+// Solidity: function demolishBuilding((uint8,uint8) action) returns()
+func (_Contract *ContractSession) DemolishBuilding(action ActionDataDemolishBuilding) (*types.Transaction, error) {
+	return _Contract.Contract.DemolishBuilding(&_Contract.TransactOpts, action)
+}
+
+// DemolishBuilding is a paid mutator transaction binding the contract method 0x8bc3817c.
+//
+// Solidity: function demolishBuilding((uint8,uint8) action) returns()
+func (_Contract *ContractTransactorSession) DemolishBuilding(action ActionDataDemolishBuilding) (*types.Transaction, error) {
+	return _Contract.Contract.DemolishBuilding(&_Contract.TransactOpts, action)
+}
+
 // Initialize is a paid mutator transaction binding the contract method 0xd1f57894.
 //
 // Solidity: function initialize(address _logic, bytes data) returns()
@@ -397,6 +538,69 @@ func (_Contract *ContractTransactorSession) Initialize(_logic common.Address, da
 	return _Contract.Contract.Initialize(&_Contract.TransactOpts, _logic, data)
 }
 
+// PlaceBuilding is a paid mutator transaction binding the contract method 0xd74de075.
+//
+// Solidity: function placeBuilding((uint8,uint8,uint16,uint16) action) returns()
+func (_Contract *ContractTransactor) PlaceBuilding(opts *bind.TransactOpts, action ActionDataPlaceBuilding) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "placeBuilding", action)
+}
+
+// PlaceBuilding is a paid mutator transaction binding the contract method 0xd74de075.
+//
+// Solidity: function placeBuilding((uint8,uint8,uint16,uint16) action) returns()
+func (_Contract *ContractSession) PlaceBuilding(action ActionDataPlaceBuilding) (*types.Transaction, error) {
+	return _Contract.Contract.PlaceBuilding(&_Contract.TransactOpts, action)
+}
+
+// PlaceBuilding is a paid mutator transaction binding the contract method 0xd74de075.
+//
+// Solidity: function placeBuilding((uint8,uint8,uint16,uint16) action) returns()
+func (_Contract *ContractTransactorSession) PlaceBuilding(action ActionDataPlaceBuilding) (*types.Transaction, error) {
+	return _Contract.Contract.PlaceBuilding(&_Contract.TransactOpts, action)
+}
+
+// RequestPause is a paid mutator transaction binding the contract method 0xef28b525.
+//
+// Solidity: function requestPause((uint8) action) returns()
+func (_Contract *ContractTransactor) RequestPause(opts *bind.TransactOpts, action ActionDataRequestPause) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "requestPause", action)
+}
+
+// RequestPause is a paid mutator transaction binding the contract method 0xef28b525.
+//
+// Solidity: function requestPause((uint8) action) returns()
+func (_Contract *ContractSession) RequestPause(action ActionDataRequestPause) (*types.Transaction, error) {
+	return _Contract.Contract.RequestPause(&_Contract.TransactOpts, action)
+}
+
+// RequestPause is a paid mutator transaction binding the contract method 0xef28b525.
+//
+// Solidity: function requestPause((uint8) action) returns()
+func (_Contract *ContractTransactorSession) RequestPause(action ActionDataRequestPause) (*types.Transaction, error) {
+	return _Contract.Contract.RequestPause(&_Contract.TransactOpts, action)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x1cdaebf7.
+//
+// Solidity: function resume((uint8) action) returns()
+func (_Contract *ContractTransactor) Resume(opts *bind.TransactOpts, action ActionDataResume) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "resume", action)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x1cdaebf7.
+//
+// Solidity: function resume((uint8) action) returns()
+func (_Contract *ContractSession) Resume(action ActionDataResume) (*types.Transaction, error) {
+	return _Contract.Contract.Resume(&_Contract.TransactOpts, action)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x1cdaebf7.
+//
+// Solidity: function resume((uint8) action) returns()
+func (_Contract *ContractTransactorSession) Resume(action ActionDataResume) (*types.Transaction, error) {
+	return _Contract.Contract.Resume(&_Contract.TransactOpts, action)
+}
+
 // Start is a paid mutator transaction binding the contract method 0xbe9a6555.
 //
 // Solidity: function start() returns()
@@ -418,6 +622,27 @@ func (_Contract *ContractTransactorSession) Start() (*types.Transaction, error)
 	return _Contract.Contract.Start(&_Contract.TransactOpts)
 }
 
+// Surrender is a paid mutator transaction binding the contract method 0x9fb278a8.
+//
+// Solidity: function surrender((uint8) action) returns()
+func (_Contract *ContractTransactor) Surrender(opts *bind.TransactOpts, action ActionDataSurrender) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "surrender", action)
+}
+
+// Surrender is a paid mutator transaction binding the contract method 0x9fb278a8.
+//
+// Solidity: function surrender((uint8) action) returns()
+func (_Contract *ContractSession) Surrender(action ActionDataSurrender) (*types.Transaction, error) {
+	return _Contract.Contract.Surrender(&_Contract.TransactOpts, action)
+}
+
+// Surrender is a paid mutator transaction binding the contract method 0x9fb278a8.
+//
+// Solidity: function surrender((uint8) action) returns()
+func (_Contract *ContractTransactorSession) Surrender(action ActionDataSurrender) (*types.Transaction, error) {
+	return _Contract.Contract.Surrender(&_Contract.TransactOpts, action)
+}
+
 // Tick is a paid mutator transaction binding the contract method 0x3eaf5d9f.
 //
 // Solidity: function tick() returns()
@@ -32,7 +32,7 @@ var (
 // ContractMetaData contains all meta data concerning the Contract contract.
 var ContractMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_maxGasAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_gameImplementation\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_coreImplementation\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"coreImplementation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"createGame\",\"inputs\":[{\"name\":\"lobbyId\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"_players\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"gameImplementation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAddressOf\",\"inputs\":[{\"name\":\"idx\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getGasAllocOf\",\"inputs\":[{\"name\":\"t\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getIndexOf\",\"inputs\":[{\"name\":\"t\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastBlock\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxGasAllocation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nActiveTickees\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setGasAlloc\",\"inputs\":[{\"name\":\"t\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"gas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"evictionBlock\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"tick\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"totalGasAllocation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"GameCreated\",\"inputs\":[{\"name\":\"gameAddress\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"lobbyId\",\"type\":\"string\",\"indexed\":false,\"internalType\":\"string\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"origin\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"GasAllocSet\",\"inputs\":[{\"name\":\"tickee\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"gas\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"evictionBlock\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ERC1167FailedCreateClone\",\"inputs\":[]}]",
-	Bin: "0x60c060405234801561001057600080fd5b506040516110e53803806110e583398101604081905261002f916100a7565b600080546001600160a01b031916339081178255604051859282917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908290a3506005556001600160a01b039182166080521660a052506100e3565b80516001600160a01b03811681146100a257600080fd5b919050565b6000806000606084860312156100bc57600080fd5b835192506100cc6020850161008b565b91506100da6040850161008b565b90509250925092565b60805160a051610fcf610116600039600081816101e201526105ac01526000818161025401526105750152610fcf6000f3fe608060405234801561001057600080fd5b50600436106100ea5760003560e01c80639d492a2c1161008c578063b0cc1f0111610066578063b0cc1f011461022a578063dbc3352a14610233578063f2fde38b1461023c578063f6cf916e1461024f57600080fd5b80639d492a2c146101dd578063a97547d714610204578063aca113131461021757600080fd5b80633eaf5d9f116100c85780633eaf5d9f1461017657806344b920b714610180578063806b984f146101c15780638da5cb5b146101ca57600080fd5b8063017df522146100ef5780632e327fef1461013b57806332f79bd814610144575b600080fd5b6101286100fd366004610b98565b6001600160a01b0316600090815260016020526040902054600160401b90046001600160401b031690565b6040519081526020015b60405180910390f35b61012860045481565b610128610152366004610b98565b6001600160a01b03166000908152600160205260409020546001600160401b031690565b61017e610276565b005b6101a961018e366004610bba565b6000908152600260205260409020546001600160a01b031690565b6040516001600160a01b039091168152602001610132565b61012860065481565b6000546101a9906001600160a01b031681565b6101a97f000000000000000000000000000000000000000000000000000000000000000081565b61017e610212366004610bd3565b610514565b6101a9610225366004610cd2565b61056d565b61012860055481565b61012860035481565b61017e61024a366004610b98565b6106a5565b6101a97f000000000000000000000000000000000000000000000000000000000000000081565b60065443116102cc5760405162461bcd60e51b815260206004820152601f60248201527f5469636b4d61737465723a206f6e6c79206f6e63652070657220626c6f636b0060448201526064015b60405180910390fd5b4360065560035460005b8181101561039c57610307604051806040016040528060078152602001662a34b1b5b2b29d60c91b81525082610739565b620124f85a1015610348576103436040518060400160405280601081526020016f2ab73232b91033b0b99036b0b933b4b760811b81525061077e565b61039c565b6000818152600260209081526040808320546001600160a01b0316808452600190925290912054600160801b90046001600160401b031643811161039257610392826000836107c4565b50506001016102d6565b5060005b600354811015610439576103d46040518060400160405280600881526020016723b0b9b632b33a1d60c11b8152505a610739565b6103fd604051806040016040528060078152602001662a34b1b5b2b29d60c91b81525082610739565b620124f85a101561043d576104396040518060400160405280601081526020016f2ab73232b91033b0b99036b0b933b4b760811b81525061077e565b5050565b6000818152600260209081526040808320546001600160a01b03168084526001909252909120546001600160401b031661047961138882610d9f565b5a1061050a5760408051600481526024810182526020810180516001600160e01b0316633eaf5d9f60e01b17905290516000916001600160a01b0385169184916104c291610dd6565b60006040518083038160008787f1925050503d8060008114610500576040519150601f19603f3d011682016040523d82523d6000602084013e610505565b606091505b505050505b50506001016103a0565b6000546001600160a01b0316331461055d5760405162461bcd60e51b815260206004820152600c60248201526b15539055551213d49256915160a21b60448201526064016102c3565b6105688383836107c4565b505050565b6000806105997f0000000000000000000000000000000000000000000000000000000000000000610a96565b9050806001600160a01b031663d1f578947f0000000000000000000000000000000000000000000000000000000000000000856040516020016105dc9190610df2565b6040516020818303038152906040526040518363ffffffff1660e01b8152600401610608929190610e6b565b600060405180830381600087803b15801561062257600080fd5b505af1158015610636573d6000803e3d6000fd5b5050505061065f8184516207a12061064e9190610e97565b61065a61025843610d9f565b6107c4565b7f6d9f5f843298227fedb5ae27fcf3ebf729b71a00cdae9de0122e48a4aed64f17818533326040516106949493929190610eae565b60405180910390a190505b92915050565b6000546001600160a01b031633146106ee5760405162461bcd60e51b815260206004820152600c60248201526b15539055551213d49256915160a21b60448201526064016102c3565b600080546001600160a01b0319166001600160a01b0383169081178255604051909133917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a350565b610439828260405160240161074f929190610ee9565b60408051601f198184030181529190526020810180516001600160e01b0316632d839cb360e21b179052610b08565b6107c1816040516024016107929190610f0b565b60408051601f198184030181529190526020810180516001600160e01b031663104c13eb60e21b179052610b08565b50565b6107fa6040518060400160405280601281526020017129b2ba3a34b7339033b0b99030b63637b19d60711b815250848484610b11565b6001600160a01b038316600090815260016020526040902060055481546004546001600160401b0390911690610831908690610d9f565b61083b9190610f1e565b106108975760405162461bcd60e51b815260206004820152602660248201527f5469636b4d61737465723a2067617320616c6c6f636174696f6e2065786365656044820152650c8e640dac2f60d31b60648201526084016102c3565b8054600480546001600160401b03909216916000906108b7908490610f1e565b9250508190555082600460008282546108d09190610d9f565b909155505060008390036109a05780546001600160401b03166000036108f65750505050565b6003805490600061090683610f31565b909155505060035460009081526002602090815260408083205484546001600160401b03600160401b918290048116865283862080546001600160a01b0319166001600160a01b03909416938417905586549286526001909452919093208054938290049092160267ffffffffffffffff60401b1990921691909117905580546fffffffffffffffffffffffffffffffff19168155610a4c565b80546001600160401b0316600003610a14576003805490819060006109c483610f48565b9091555050815467ffffffffffffffff60401b1916600160401b6001600160401b03831602178255600090815260026020526040902080546001600160a01b0319166001600160a01b0386161790555b80546001600160401b03838116600160801b0277ffffffffffffffff0000000000000000ffffffffffffffff19909216908516171781555b60408051848152602081018490526001600160a01b038616917fde5be304e9fb13da67e61f6d156dd2aa96789f8e81a9a690e6d4e434fcb6cb35910160405180910390a250505050565b6000763d602d80600a3d3981f3363d3d373d3d3d363d730000008260601b60e81c176000526e5af43d82803e903d91602b57fd5bf38260781b17602052603760096000f090506001600160a01b038116610b03576040516330be1a3d60e21b815260040160405180910390fd5b919050565b6107c181610b60565b610b5a84848484604051602401610b2b9493929190610f61565b60408051601f198184030181529190526020810180516001600160e01b0316637c7a8d8f60e11b179052610b08565b50505050565b80516a636f6e736f6c652e6c6f67602083016000808483855afa5050505050565b80356001600160a01b0381168114610b0357600080fd5b600060208284031215610baa57600080fd5b610bb382610b81565b9392505050565b600060208284031215610bcc57600080fd5b5035919050565b600080600060608486031215610be857600080fd5b610bf184610b81565b95602085013595506040909401359392505050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f191681016001600160401b0381118282101715610c4457610c44610c06565b604052919050565b600082601f830112610c5d57600080fd5b813560206001600160401b03821115610c7857610c78610c06565b8160051b610c87828201610c1c565b9283528481018201928281019087851115610ca157600080fd5b83870192505b84831015610cc757610cb883610b81565b82529183019190830190610ca7565b979650505050505050565b60008060408385031215610ce557600080fd5b82356001600160401b0380821115610cfc57600080fd5b818501915085601f830112610d1057600080fd5b8135602082821115610d2457610d24610c06565b610d36601f8301601f19168201610c1c565b8281528882848701011115610d4a57600080fd5b82828601838301376000928101820192909252909450850135915080821115610d7257600080fd5b50610d7f85828601610c4c565b9150509250929050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561069f5761069f610d89565b60005b83811015610dcd578181015183820152602001610db5565b50506000910152565b60008251610de8818460208701610db2565b9190910192915050565b6020808252825182820181905260009190848201906040850190845b81811015610e335783516001600160a01b031683529284019291840191600101610e0e565b50909695505050505050565b60008151808452610e57816020860160208601610db2565b601f01601f19169290920160200192915050565b6001600160a01b0383168152604060208201819052600090610e8f90830184610e3f565b949350505050565b808202811582820484141761069f5761069f610d89565b600060018060a01b03808716835260806020840152610ed06080840187610e3f565b9481166040840152929092166060909101525092915050565b604081526000610efc6040830185610e3f565b90508260208301529392505050565b602081526000610bb36020830184610e3f565b8181038181111561069f5761069f610d89565b600081610f4057610f40610d89565b506000190190565b600060018201610f5a57610f5a610d89565b5060010190565b608081526000610f746080830187610e3f565b6001600160a01b0395909516602083015250604081019290925260609091015291905056fea26469706673582212200833f0b535ec89b89b6e7cc1914b4ed8dcfa5d7da9fba021e93e29377c9e8b2164736f6c63430008190033",
+	Bin: "0x60c060405234801561001057600080fd5b506040516110fd3803806110fd83398101604081905261002f916100a7565b600080546001600160a01b031916339081178255604051859282917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908290a3506005556001600160a01b039182166080521660a052506100e3565b80516001600160a01b03811681146100a257600080fd5b919050565b6000806000606084860312156100bc57600080fd5b835192506100cc6020850161008b565b91506100da6040850161008b565b90509250925092565b60805160a051610fe7610116600039600081816101e201526105ac01526000818161025401526105750152610fe76000f3fe608060405234801561001057600080fd5b50600436106100ea5760003560e01c80639d492a2c1161008c578063b0cc1f0111610066578063b0cc1f011461022a578063dbc3352a14610233578063f2fde38b1461023c578063f6cf916e1461024f57600080fd5b80639d492a2c146101dd578063a97547d714610204578063aca113131461021757600080fd5b80633eaf5d9f116100c85780633eaf5d9f1461017657806344b920b714610180578063806b984f146101c15780638da5cb5b146101ca57600080fd5b8063017df522146100ef5780632e327fef1461013b57806332f79bd814610144575b600080fd5b6101286100fd366004610b98565b6001600160a01b0316600090815260016020526040902054600160401b90046001600160401b031690565b6040519081526020015b60405180910390f35b61012860045481565b610128610152366004610b98565b6001600160a01b03166000908152600160205260409020546001600160401b031690565b61017e610276565b005b6101a961018e366004610bba565b6000908152600260205260409020546001600160a01b031690565b6040516001600160a01b039091168152602001610132565b61012860065481565b6000546101a9906001600160a01b031681565b6101a97f000000000000000000000000000000000000000000000000000000000000000081565b61017e610212366004610bd3565b610514565b6101a9610225366004610cd5565b61056d565b61012860055481565b61012860035481565b61017e61024a366004610b98565b6106a5565b6101a97f000000000000000000000000000000000000000000000000000000000000000081565b60065443116102cc5760405162461bcd60e51b815260206004820152601f60248201527f5469636b4d61737465723a206f6e6c79206f6e63652070657220626c6f636b0060448201526064015b60405180910390fd5b4360065560035460005b8181101561039c57610307604051806040016040528060078152602001662a34b1b5b2b29d60c91b81525082610739565b620124f85a1015610348576103436040518060400160405280601081526020016f2ab73232b91033b0b99036b0b933b4b760811b81525061077e565b61039c565b6000818152600260209081526040808320546001600160a01b0316808452600190925290912054600160801b90046001600160401b031643811161039257610392826000836107c4565b50506001016102d6565b5060005b600354811015610439576103d46040518060400160405280600881526020016723b0b9b632b33a1d60c11b8152505a610739565b6103fd604051806040016040528060078152602001662a34b1b5b2b29d60c91b81525082610739565b620124f85a101561043d576104396040518060400160405280601081526020016f2ab73232b91033b0b99036b0b933b4b760811b81525061077e565b5050565b6000818152600260209081526040808320546001600160a01b03168084526001909252909120546001600160401b031661047961138882610dae565b5a1061050a5760408051600481526024810182526020810180516001600160e01b0316633eaf5d9f60e01b17905290516000916001600160a01b0385169184916104c291610de5565b60006040518083038160008787f1925050503d8060008114610500576040519150601f19603f3d011682016040523d82523d6000602084013e610505565b606091505b505050505b50506001016103a0565b6000546001600160a01b0316331461055d5760405162461bcd60e51b815260206004820152600c60248201526b15539055551213d49256915160a21b60448201526064016102c3565b6105688383836107c4565b505050565b6000806105997f0000000000000000000000000000000000000000000000000000000000000000610a96565b9050806001600160a01b031663d1f578947f0000000000000000000000000000000000000000000000000000000000000000856040516020016105dc9190610e01565b6040516020818303038152906040526040518363ffffffff1660e01b8152600401610608929190610e79565b600060405180830381600087803b15801561062257600080fd5b505af1158015610636573d6000803e3d6000fd5b5050505061065f8184516207a12061064e9190610ea5565b61065a61025843610dae565b6107c4565b7f6d9f5f843298227fedb5ae27fcf3ebf729b71a00cdae9de0122e48a4aed64f17818533326040516106949493929190610ebc565b60405180910390a190505b92915050565b6000546001600160a01b031633146106ee5760405162461bcd60e51b815260206004820152600c60248201526b15539055551213d49256915160a21b60448201526064016102c3565b600080546001600160a01b0319166001600160a01b0383169081178255604051909133917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a350565b610439828260405160240161074f929190610f01565b60408051601f198184030181529190526020810180516001600160e01b0316632d839cb360e21b179052610b08565b6107c1816040516024016107929190610f23565b60408051601f198184030181529190526020810180516001600160e01b031663104c13eb60e21b179052610b08565b50565b6107fa6040518060400160405280601281526020017129b2ba3a34b7339033b0b99030b63637b19d60711b815250848484610b11565b6001600160a01b038316600090815260016020526040902060055481546004546001600160401b0390911690610831908690610dae565b61083b9190610f36565b106108975760405162461bcd60e51b815260206004820152602660248201527f5469636b4d61737465723a2067617320616c6c6f636174696f6e2065786365656044820152650c8e640dac2f60d31b60648201526084016102c3565b8054600480546001600160401b03909216916000906108b7908490610f36565b9250508190555082600460008282546108d09190610dae565b909155505060008390036109a05780546001600160401b03166000036108f65750505050565b6003805490600061090683610f49565b909155505060035460009081526002602090815260408083205484546001600160401b03600160401b918290048116865283862080546001600160a01b0319166001600160a01b03909416938417905586549286526001909452919093208054938290049092160267ffffffffffffffff60401b1990921691909117905580546fffffffffffffffffffffffffffffffff19168155610a4c565b80546001600160401b0316600003610a14576003805490819060006109c483610f60565b9091555050815467ffffffffffffffff60401b1916600160401b6001600160401b03831602178255600090815260026020526040902080546001600160a01b0319166001600160a01b0386161790555b80546001600160401b03838116600160801b0277ffffffffffffffff0000000000000000ffffffffffffffff19909216908516171781555b60408051848152602081018490526001600160a01b038616917fde5be304e9fb13da67e61f6d156dd2aa96789f8e81a9a690e6d4e434fcb6cb35910160405180910390a250505050565b6000763d602d80600a3d3981f3363d3d373d3d3d363d730000008260601b60e81c176000526e5af43d82803e903d91602b57fd5bf38260781b17602052603760096000f090506001600160a01b038116610b03576040516330be1a3d60e21b815260040160405180910390fd5b919050565b6107c181610b60565b610b5a84848484604051602401610b2b9493929190610f79565b60408051601f198184030181529190526020810180516001600160e01b0316637c7a8d8f60e11b179052610b08565b50505050565b60006a636f6e736f6c652e6c6f679050600080835160208501845afa505050565b80356001600160a01b0381168114610b0357600080fd5b600060208284031215610baa57600080fd5b610bb382610b81565b9392505050565b600060208284031215610bcc57600080fd5b5035919050565b600080600060608486031215610be857600080fd5b610bf184610b81565b95602085013595506040909401359392505050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f191681016001600160401b0381118282101715610c4457610c44610c06565b604052919050565b600082601f830112610c5d57600080fd5b81356001600160401b03811115610c7657610c76610c06565b8060051b610c8660208201610c1c565b91825260208185018101929081019086841115610ca257600080fd5b6020860192505b83831015610ccb57610cba83610b81565b825260209283019290910190610ca9565b9695505050505050565b60008060408385031215610ce857600080fd5b82356001600160401b03811115610cfe57600080fd5b8301601f81018513610d0f57600080fd5b80356001600160401b03811115610d2857610d28610c06565b610d3b601f8201601f1916602001610c1c565b818152866020838501011115610d5057600080fd5b8160208401602083013760006020838301015280945050505060208301356001600160401b03811115610d8257600080fd5b610d8e85828601610c4c565b9150509250929050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561069f5761069f610d98565b60005b83811015610ddc578181015183820152602001610dc4565b50506000910152565b60008251610df7818460208701610dc1565b9190910192915050565b602080825282518282018190526000918401906040840190835b81811015610e425783516001600160a01b0316835260209384019390920191600101610e1b565b509095945050505050565b60008151808452610e65816020860160208601610dc1565b601f01601f19169290920160200192915050565b6001600160a01b0383168152604060208201819052600090610e9d90830184610e4d565b949350505050565b808202811582820484141761069f5761069f610d98565b6001600160a01b0385168152608060208201819052600090610ee090830186610e4d565b6001600160a01b039485166040840152929093166060909101529392505050565b604081526000610f146040830185610e4d565b90508260208301529392505050565b602081526000610bb36020830184610e4d565b8181038181111561069f5761069f610d98565b600081610f5857610f58610d98565b506000190190565b600060018201610f7257610f72610d98565b5060010190565b608081526000610f8c6080830187610e4d565b6001600160a01b0395909516602083015250604081019290925260609091015291905056fea26469706673582212205542d6c50a298a1535cfb95dc479562146e446e06e26f7b55bce947289e36f8464736f6c634300081e0033",
 }
 
 // ContractABI is the input ABI used to generate the binding from.
@@ -38,6 +38,7 @@ type RowDataBoard struct {
 	HoverUnitId    uint8
 	AirPlayerId    uint8
 	AirUnitId      uint8
+	Terrain        uint8
 }
 
 // RowDataBuildingPrototypes is an auto generated low-level Go binding around an user-defined struct.
@@ -51,30 +52,50 @@ type RowDataBuildingPrototypes struct {
 	MineTime         uint8
 	MaxIntegrity     uint8
 	BuildingTime     uint8
+	VisionRadius     uint8
 	IsArmory         bool
 	IsEnvironment    bool
+	ResourceReserve  uint16
 }
 
 // RowDataBuildings is an auto generated low-level Go binding around an user-defined struct.
 type RowDataBuildings struct {
-	X            uint16
-	Y            uint16
-	BuildingType uint8
-	State        uint8
-	Integrity    uint8
-	Timestamp    uint32
+	X               uint16
+	Y               uint16
+	BuildingType    uint8
+	State           uint8
+	Integrity       uint8
+	Timestamp       uint32
+	ResourceReserve uint16
+}
+
+// RowDataDamageMatrix is an auto generated low-level Go binding around an user-defined struct.
+type RowDataDamageMatrix struct {
+	Strength uint8
+	IsSet    bool
 }
 
 // RowDataMeta is an auto generated low-level Go binding around an user-defined struct.
 type RowDataMeta struct {
-	BoardWidth             uint16
-	BoardHeight            uint16
-	PlayerCount            uint8
-	UnitPrototypeCount     uint8
-	BuildingPrototypeCount uint8
-	IsInitialized          bool
-	HasStarted             bool
-	CreationBlockNumber    uint32
+	BoardWidth              uint16
+	BoardHeight             uint16
+	PlayerCount             uint8
+	UnitPrototypeCount      uint8
+	BuildingPrototypeCount  uint8
+	IsInitialized           bool
+	HasStarted              bool
+	CreationBlockNumber     uint32
+	MaxTicks                uint32
+	IsGameOver              bool
+	WinnerId                uint8
+	EndTick                 uint32
+	IsPaused                bool
+	PausedBy                uint8
+	PausedTicks             uint32
+	CurrentPauseTicks       uint32
+	TicksPerBlock           uint8
+	DemolitionRefundPercent uint8
+	PathFindNodeBudget      uint16
 }
 
 // RowDataPlayers is an auto generated low-level Go binding around an user-defined struct.
@@ -91,29 +112,39 @@ type RowDataPlayers struct {
 	ComputeSupply             uint8
 	ComputeDemand             uint8
 	UnitCount                 uint8
+	LastUnitId                uint8
 	BuildingCount             uint8
 	BuildingPayQueuePointer   uint8
 	BuildingBuildQueuePointer uint8
 	UnitPayQueuePointer       uint8
 	UnpurgeableUnitCount      uint8
+	TeamId                    uint8
+	IsEliminated              bool
+	IsPauseRequested          bool
 }
 
 // RowDataUnitPrototypes is an auto generated low-level Go binding around an user-defined struct.
 type RowDataUnitPrototypes struct {
-	Layer             uint8
-	ResourceCost      uint16
-	ComputeCost       uint8
-	SpawnTime         uint8
-	MaxIntegrity      uint8
-	LandStrength      uint8
-	HoverStrength     uint8
-	AirStrength       uint8
-	AttackRange       uint8
-	AttackCooldown    uint8
-	IsAssault         bool
-	IsConfrontational bool
-	IsWorker          bool
-	IsPurgeable       bool
+	Layer              uint8
+	ResourceCost       uint16
+	ComputeCost        uint8
+	SpawnTime          uint8
+	MaxIntegrity       uint8
+	LandStrength       uint8
+	HoverStrength      uint8
+	AirStrength        uint8
+	AttackRange        uint8
+	AttackCooldown     uint8
+	VisionRadius       uint8
+	SplashRadius       uint8
+	SplashFalloff      uint8
+	RepairCooldown     uint8
+	RepairIntegrity    uint8
+	RepairResourceCost uint8
+	IsAssault          bool
+	IsConfrontational  bool
+	IsWorker           bool
+	IsPurgeable        bool
 }
 
 // RowDataUnits is an auto generated low-level Go binding around an user-defined struct.
@@ -133,7 +164,7 @@ type RowDataUnits struct {
 
 // ContractMetaData contains all meta data concerning the Contract contract.
 var ContractMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"function\",\"name\":\"getBoardRow\",\"inputs\":[{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Board\",\"components\":[{\"name\":\"landObjectType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landPlayerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landObjectId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverPlayerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverUnitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airPlayerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airUnitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBuildingPrototypesRow\",\"inputs\":[{\"name\":\"buildingType\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_BuildingPrototypes\",\"components\":[{\"name\":\"width\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"height\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"resourceCapacity\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCapacity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceMine\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"mineTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isArmory\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isEnvironment\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBuildingsRow\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Buildings\",\"components\":[{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"buildingType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"state\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"integrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"timestamp\",\"type\":\"uint32\",\"internalType\":\"uint32\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMetaRow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Meta\",\"components\":[{\"name\":\"boardWidth\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"boardHeight\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"playerCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitPrototypeCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingPrototypeCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isInitialized\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"hasStarted\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"creationBlockNumber\",\"type\":\"uint32\",\"internalType\":\"uint32\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPlayersRow\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Players\",\"components\":[{\"name\":\"spawnAreaX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaWidth\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnAreaHeight\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"workerPortX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"workerPortY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"curResource\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"maxResource\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"curArmories\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"computeSupply\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"computeDemand\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingPayQueuePointer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingBuildQueuePointer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitPayQueuePointer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unpurgeableUnitCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnitPrototypesRow\",\"inputs\":[{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_UnitPrototypes\",\"components\":[{\"name\":\"layer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCost\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackRange\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackCooldown\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isAssault\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isConfrontational\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isWorker\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isPurgeable\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnitsRow\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Units\",\"components\":[{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"state\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"load\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"integrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"timestamp\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"command\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandExtra\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandMeta\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isPreTicked\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"}]",
+	ABI: "[{\"type\":\"function\",\"name\":\"getBoardRow\",\"inputs\":[{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Board\",\"components\":[{\"name\":\"landObjectType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landPlayerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landObjectId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverPlayerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverUnitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airPlayerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airUnitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"terrain\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBuildingPrototypesRow\",\"inputs\":[{\"name\":\"buildingType\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_BuildingPrototypes\",\"components\":[{\"name\":\"width\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"height\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"resourceCapacity\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCapacity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceMine\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"mineTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"visionRadius\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isArmory\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isEnvironment\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"resourceReserve\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBuildingsRow\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Buildings\",\"components\":[{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"buildingType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"state\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"integrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"timestamp\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"resourceReserve\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDamageMatrixRow\",\"inputs\":[{\"name\":\"attackerType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"targetType\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_DamageMatrix\",\"components\":[{\"name\":\"strength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isSet\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMetaRow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Meta\",\"components\":[{\"name\":\"boardWidth\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"boardHeight\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"playerCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitPrototypeCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingPrototypeCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isInitialized\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"hasStarted\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"creationBlockNumber\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"maxTicks\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"isGameOver\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"winnerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"endTick\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"isPaused\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"pausedBy\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"pausedTicks\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"currentPauseTicks\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"ticksPerBlock\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"demolitionRefundPercent\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"pathFindNodeBudget\",\"type\":\"uint16\",\"internalType\":\"uint16\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPlayersRow\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Players\",\"components\":[{\"name\":\"spawnAreaX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"spawnAreaWidth\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnAreaHeight\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"workerPortX\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"workerPortY\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"curResource\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"maxResource\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"curArmories\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"computeSupply\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"computeDemand\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"lastUnitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingPayQueuePointer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"buildingBuildQueuePointer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitPayQueuePointer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unpurgeableUnitCount\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"teamId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isEliminated\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isPauseRequested\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnitPrototypesRow\",\"inputs\":[{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_UnitPrototypes\",\"components\":[{\"name\":\"layer\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"resourceCost\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"computeCost\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"spawnTime\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"maxIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"landStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"hoverStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"airStrength\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackRange\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"attackCooldown\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"visionRadius\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"splashRadius\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"splashFalloff\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"repairCooldown\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"repairIntegrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"repairResourceCost\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isAssault\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isConfrontational\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isWorker\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"isPurgeable\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnitsRow\",\"inputs\":[{\"name\":\"playerId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"unitId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structRowData_Units\",\"components\":[{\"name\":\"x\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"y\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"unitType\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"state\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"load\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"integrity\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"timestamp\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"command\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandExtra\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"commandMeta\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"isPreTicked\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"stateMutability\":\"view\"}]",
 }
 
 // ContractABI is the input ABI used to generate the binding from.
@@ -284,7 +315,7 @@ func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method
 
 // GetBoardRow is a free data retrieval call binding the contract method 0x2f5d0157.
 //
-// Solidity: function getBoardRow(uint16 x, uint16 y) view returns((uint8,uint8,uint8,uint8,uint8,uint8,uint8))
+// Solidity: function getBoardRow(uint16 x, uint16 y) view returns((uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8))
 func (_Contract *ContractCaller) GetBoardRow(opts *bind.CallOpts, x uint16, y uint16) (RowDataBoard, error) {
 	var out []interface{}
 	err := _Contract.contract.Call(opts, &out, "getBoardRow", x, y)
@@ -301,21 +332,21 @@ func (_Contract *ContractCaller) GetBoardRow(opts *bind.CallOpts, x uint16, y ui
 
 // GetBoardRow is a free data retrieval call binding the contract method 0x2f5d0157.
 //
-// Solidity: function getBoardRow(uint16 x, uint16 y) view returns((uint8,uint8,uint8,uint8,uint8,uint8,uint8))
+// Solidity: function getBoardRow(uint16 x, uint16 y) view returns((uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8))
 func (_Contract *ContractSession) GetBoardRow(x uint16, y uint16) (RowDataBoard, error) {
 	return _Contract.Contract.GetBoardRow(&_Contract.CallOpts, x, y)
 }
 
 // GetBoardRow is a free data retrieval call binding the contract method 0x2f5d0157.
 //
-// Solidity: function getBoardRow(uint16 x, uint16 y) view returns((uint8,uint8,uint8,uint8,uint8,uint8,uint8))
+// Solidity: function getBoardRow(uint16 x, uint16 y) view returns((uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8))
 func (_Contract *ContractCallerSession) GetBoardRow(x uint16, y uint16) (RowDataBoard, error) {
 	return _Contract.Contract.GetBoardRow(&_Contract.CallOpts, x, y)
 }
 
 // GetBuildingPrototypesRow is a free data retrieval call binding the contract method 0xad986db0.
 //
-// Solidity: function getBuildingPrototypesRow(uint8 buildingType) view returns((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,bool,bool))
+// Solidity: function getBuildingPrototypesRow(uint8 buildingType) view returns((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,uint16))
 func (_Contract *ContractCaller) GetBuildingPrototypesRow(opts *bind.CallOpts, buildingType uint8) (RowDataBuildingPrototypes, error) {
 	var out []interface{}
 	err := _Contract.contract.Call(opts, &out, "getBuildingPrototypesRow", buildingType)
@@ -332,21 +363,21 @@ func (_Contract *ContractCaller) GetBuildingPrototypesRow(opts *bind.CallOpts, b
 
 // GetBuildingPrototypesRow is a free data retrieval call binding the contract method 0xad986db0.
 //
-// Solidity: function getBuildingPrototypesRow(uint8 buildingType) view returns((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,bool,bool))
+// Solidity: function getBuildingPrototypesRow(uint8 buildingType) view returns((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,uint16))
 func (_Contract *ContractSession) GetBuildingPrototypesRow(buildingType uint8) (RowDataBuildingPrototypes, error) {
 	return _Contract.Contract.GetBuildingPrototypesRow(&_Contract.CallOpts, buildingType)
 }
 
 // GetBuildingPrototypesRow is a free data retrieval call binding the contract method 0xad986db0.
 //
-// Solidity: function getBuildingPrototypesRow(uint8 buildingType) view returns((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,bool,bool))
+// Solidity: function getBuildingPrototypesRow(uint8 buildingType) view returns((uint8,uint8,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,uint16))
 func (_Contract *ContractCallerSession) GetBuildingPrototypesRow(buildingType uint8) (RowDataBuildingPrototypes, error) {
 	return _Contract.Contract.GetBuildingPrototypesRow(&_Contract.CallOpts, buildingType)
 }
 
 // GetBuildingsRow is a free data retrieval call binding the contract method 0xeed886d9.
 //
-// Solidity: function getBuildingsRow(uint8 playerId, uint8 buildingId) view returns((uint16,uint16,uint8,uint8,uint8,uint32))
+// Solidity: function getBuildingsRow(uint8 playerId, uint8 buildingId) view returns((uint16,uint16,uint8,uint8,uint8,uint32,uint16))
 func (_Contract *ContractCaller) GetBuildingsRow(opts *bind.CallOpts, playerId uint8, buildingId uint8) (RowDataBuildings, error) {
 	var out []interface{}
 	err := _Contract.contract.Call(opts, &out, "getBuildingsRow", playerId, buildingId)
@@ -363,21 +394,52 @@ func (_Contract *ContractCaller) GetBuildingsRow(opts *bind.CallOpts, playerId u
 
 // GetBuildingsRow is a free data retrieval call binding the contract method 0xeed886d9.
 //
-// Solidity: function getBuildingsRow(uint8 playerId, uint8 buildingId) view returns((uint16,uint16,uint8,uint8,uint8,uint32))
+// Solidity: function getBuildingsRow(uint8 playerId, uint8 buildingId) view returns((uint16,uint16,uint8,uint8,uint8,uint32,uint16))
 func (_Contract *ContractSession) GetBuildingsRow(playerId uint8, buildingId uint8) (RowDataBuildings, error) {
 	return _Contract.Contract.GetBuildingsRow(&_Contract.CallOpts, playerId, buildingId)
 }
 
 // GetBuildingsRow is a free data retrieval call binding the contract method 0xeed886d9.
 //
-// Solidity: function getBuildingsRow(uint8 playerId, uint8 buildingId) view returns((uint16,uint16,uint8,uint8,uint8,uint32))
+// Solidity: function getBuildingsRow(uint8 playerId, uint8 buildingId) view returns((uint16,uint16,uint8,uint8,uint8,uint32,uint16))
 func (_Contract *ContractCallerSession) GetBuildingsRow(playerId uint8, buildingId uint8) (RowDataBuildings, error) {
 	return _Contract.Contract.GetBuildingsRow(&_Contract.CallOpts, playerId, buildingId)
 }
 
+// GetDamageMatrixRow is a free data retrieval call binding the contract method 0x395404da.
+//
+// Solidity: function getDamageMatrixRow(uint8 attackerType, uint8 targetType) view returns((uint8,bool))
+func (_Contract *ContractCaller) GetDamageMatrixRow(opts *bind.CallOpts, attackerType uint8, targetType uint8) (RowDataDamageMatrix, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getDamageMatrixRow", attackerType, targetType)
+
+	if err != nil {
+		return *new(RowDataDamageMatrix), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(RowDataDamageMatrix)).(*RowDataDamageMatrix)
+
+	return out0, err
+
+}
+
+// GetDamageMatrixRow is a free data retrieval call binding the contract method 0x395404da.
+//
+// Solidity: function getDamageMatrixRow(uint8 attackerType, uint8 targetType) view returns((uint8,bool))
+func (_Contract *ContractSession) GetDamageMatrixRow(attackerType uint8, targetType uint8) (RowDataDamageMatrix, error) {
+	return _Contract.Contract.GetDamageMatrixRow(&_Contract.CallOpts, attackerType, targetType)
+}
+
+// GetDamageMatrixRow is a free data retrieval call binding the contract method 0x395404da.
+//
+// Solidity: function getDamageMatrixRow(uint8 attackerType, uint8 targetType) view returns((uint8,bool))
+func (_Contract *ContractCallerSession) GetDamageMatrixRow(attackerType uint8, targetType uint8) (RowDataDamageMatrix, error) {
+	return _Contract.Contract.GetDamageMatrixRow(&_Contract.CallOpts, attackerType, targetType)
+}
+
 // GetMetaRow is a free data retrieval call binding the contract method 0x422f7e1d.
 //
-// Solidity: function getMetaRow() view returns((uint16,uint16,uint8,uint8,uint8,bool,bool,uint32))
+// Solidity: function getMetaRow() view returns((uint16,uint16,uint8,uint8,uint8,bool,bool,uint32,uint32,bool,uint8,uint32,bool,uint8,uint32,uint32,uint8,uint8,uint16))
 func (_Contract *ContractCaller) GetMetaRow(opts *bind.CallOpts) (RowDataMeta, error) {
 	var out []interface{}
 	err := _Contract.contract.Call(opts, &out, "getMetaRow")
@@ -394,21 +456,21 @@ func (_Contract *ContractCaller) GetMetaRow(opts *bind.CallOpts) (RowDataMeta, e
 
 // GetMetaRow is a free data retrieval call binding the contract method 0x422f7e1d.
 //
-// Solidity: function getMetaRow() view returns((uint16,uint16,uint8,uint8,uint8,bool,bool,uint32))
+// Solidity: function getMetaRow() view returns((uint16,uint16,uint8,uint8,uint8,bool,bool,uint32,uint32,bool,uint8,uint32,bool,uint8,uint32,uint32,uint8,uint8,uint16))
 func (_Contract *ContractSession) GetMetaRow() (RowDataMeta, error) {
 	return _Contract.Contract.GetMetaRow(&_Contract.CallOpts)
 }
 
 // GetMetaRow is a free data retrieval call binding the contract method 0x422f7e1d.
 //
-// Solidity: function getMetaRow() view returns((uint16,uint16,uint8,uint8,uint8,bool,bool,uint32))
+// Solidity: function getMetaRow() view returns((uint16,uint16,uint8,uint8,uint8,bool,bool,uint32,uint32,bool,uint8,uint32,bool,uint8,uint32,uint32,uint8,uint8,uint16))
 func (_Contract *ContractCallerSession) GetMetaRow() (RowDataMeta, error) {
 	return _Contract.Contract.GetMetaRow(&_Contract.CallOpts)
 }
 
 // GetPlayersRow is a free data retrieval call binding the contract method 0x051cfce4.
 //
-// Solidity: function getPlayersRow(uint8 playerId) view returns((uint16,uint16,uint8,uint8,uint16,uint16,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8))
+// Solidity: function getPlayersRow(uint8 playerId) view returns((uint16,uint16,uint8,uint8,uint16,uint16,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool))
 func (_Contract *ContractCaller) GetPlayersRow(opts *bind.CallOpts, playerId uint8) (RowDataPlayers, error) {
 	var out []interface{}
 	err := _Contract.contract.Call(opts, &out, "getPlayersRow", playerId)
@@ -425,21 +487,21 @@ func (_Contract *ContractCaller) GetPlayersRow(opts *bind.CallOpts, playerId uin
 
 // GetPlayersRow is a free data retrieval call binding the contract method 0x051cfce4.
 //
-// Solidity: function getPlayersRow(uint8 playerId) view returns((uint16,uint16,uint8,uint8,uint16,uint16,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8))
+// Solidity: function getPlayersRow(uint8 playerId) view returns((uint16,uint16,uint8,uint8,uint16,uint16,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool))
 func (_Contract *ContractSession) GetPlayersRow(playerId uint8) (RowDataPlayers, error) {
 	return _Contract.Contract.GetPlayersRow(&_Contract.CallOpts, playerId)
 }
 
 // GetPlayersRow is a free data retrieval call binding the contract method 0x051cfce4.
 //
-// Solidity: function getPlayersRow(uint8 playerId) view returns((uint16,uint16,uint8,uint8,uint16,uint16,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8))
+// Solidity: function getPlayersRow(uint8 playerId) view returns((uint16,uint16,uint8,uint8,uint16,uint16,uint16,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool))
 func (_Contract *ContractCallerSession) GetPlayersRow(playerId uint8) (RowDataPlayers, error) {
 	return _Contract.Contract.GetPlayersRow(&_Contract.CallOpts, playerId)
 }
 
 // GetUnitPrototypesRow is a free data retrieval call binding the contract method 0x1903dc4a.
 //
-// Solidity: function getUnitPrototypesRow(uint8 unitType) view returns((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool))
+// Solidity: function getUnitPrototypesRow(uint8 unitType) view returns((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool))
 func (_Contract *ContractCaller) GetUnitPrototypesRow(opts *bind.CallOpts, unitType uint8) (RowDataUnitPrototypes, error) {
 	var out []interface{}
 	err := _Contract.contract.Call(opts, &out, "getUnitPrototypesRow", unitType)
@@ -456,14 +518,14 @@ func (_Contract *ContractCaller) GetUnitPrototypesRow(opts *bind.CallOpts, unitT
 
 // GetUnitPrototypesRow is a free data retrieval call binding the contract method 0x1903dc4a.
 //
-// Solidity: function getUnitPrototypesRow(uint8 unitType) view returns((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool))
+// Solidity: function getUnitPrototypesRow(uint8 unitType) view returns((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool))
 func (_Contract *ContractSession) GetUnitPrototypesRow(unitType uint8) (RowDataUnitPrototypes, error) {
 	return _Contract.Contract.GetUnitPrototypesRow(&_Contract.CallOpts, unitType)
 }
 
 // GetUnitPrototypesRow is a free data retrieval call binding the contract method 0x1903dc4a.
 //
-// Solidity: function getUnitPrototypesRow(uint8 unitType) view returns((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool))
+// Solidity: function getUnitPrototypesRow(uint8 unitType) view returns((uint8,uint16,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,bool,bool,bool,bool))
 func (_Contract *ContractCallerSession) GetUnitPrototypesRow(unitType uint8) (RowDataUnitPrototypes, error) {
 	return _Contract.Contract.GetUnitPrototypesRow(&_Contract.CallOpts, unitType)
 }
@@ -12,19 +12,32 @@ var (
 
 /*
 Table                 KeySize  ValueSize
-Initialize            0        4
+Initialize            0        12
 Start                 0        0
 CreateUnit            0        6
 AssignUnit            0        19
+AssignUnits           0        50
 PlaceBuilding         0        6
-AddPlayer             0        11
-AddUnitPrototype      0        15
-AddBuildingPrototype  0        13
+Surrender             0        1
+RequestPause          0        1
+Resume                0        1
+AddPlayer             0        12
+AddUnitPrototype      0        21
+AddBuildingPrototype  0        16
+SetDamage             0        3
+SetMineReserve        0        3
+SetTerrain            0        5
+CancelUnit            0        2
+DemolishBuilding      0        2
 */
 
 type ActionData_Initialize struct {
-	Width  uint16 `json:"width"`
-	Height uint16 `json:"height"`
+	Width                   uint16 `json:"width"`
+	Height                  uint16 `json:"height"`
+	MaxTicks                uint32 `json:"maxTicks"`
+	TicksPerBlock           uint8  `json:"ticksPerBlock"`
+	DemolitionRefundPercent uint8  `json:"demolitionRefundPercent"`
+	PathFindNodeBudget      uint16 `json:"pathFindNodeBudget"`
 }
 
 func (row *ActionData_Initialize) GetWidth() uint16 {
@@ -35,6 +48,22 @@ func (row *ActionData_Initialize) GetHeight() uint16 {
 	return row.Height
 }
 
+func (row *ActionData_Initialize) GetMaxTicks() uint32 {
+	return row.MaxTicks
+}
+
+func (row *ActionData_Initialize) GetTicksPerBlock() uint8 {
+	return row.TicksPerBlock
+}
+
+func (row *ActionData_Initialize) GetDemolitionRefundPercent() uint8 {
+	return row.DemolitionRefundPercent
+}
+
+func (row *ActionData_Initialize) GetPathFindNodeBudget() uint16 {
+	return row.PathFindNodeBudget
+}
+
 type ActionData_Start struct {
 }
 
@@ -89,6 +118,49 @@ func (row *ActionData_AssignUnit) GetCommandMeta() uint8 {
 	return row.CommandMeta
 }
 
+type ActionData_AssignUnits struct {
+	PlayerId     uint8  `json:"playerId"`
+	UnitMask0    uint64 `json:"unitMask0"`
+	UnitMask1    uint64 `json:"unitMask1"`
+	UnitMask2    uint64 `json:"unitMask2"`
+	UnitMask3    uint64 `json:"unitMask3"`
+	Command      uint64 `json:"command"`
+	CommandExtra uint64 `json:"commandExtra"`
+	CommandMeta  uint8  `json:"commandMeta"`
+}
+
+func (row *ActionData_AssignUnits) GetPlayerId() uint8 {
+	return row.PlayerId
+}
+
+func (row *ActionData_AssignUnits) GetUnitMask0() uint64 {
+	return row.UnitMask0
+}
+
+func (row *ActionData_AssignUnits) GetUnitMask1() uint64 {
+	return row.UnitMask1
+}
+
+func (row *ActionData_AssignUnits) GetUnitMask2() uint64 {
+	return row.UnitMask2
+}
+
+func (row *ActionData_AssignUnits) GetUnitMask3() uint64 {
+	return row.UnitMask3
+}
+
+func (row *ActionData_AssignUnits) GetCommand() uint64 {
+	return row.Command
+}
+
+func (row *ActionData_AssignUnits) GetCommandExtra() uint64 {
+	return row.CommandExtra
+}
+
+func (row *ActionData_AssignUnits) GetCommandMeta() uint8 {
+	return row.CommandMeta
+}
+
 type ActionData_PlaceBuilding struct {
 	PlayerId     uint8  `json:"playerId"`
 	BuildingType uint8  `json:"buildingType"`
@@ -112,6 +184,30 @@ func (row *ActionData_PlaceBuilding) GetY() uint16 {
 	return row.Y
 }
 
+type ActionData_Surrender struct {
+	PlayerId uint8 `json:"playerId"`
+}
+
+func (row *ActionData_Surrender) GetPlayerId() uint8 {
+	return row.PlayerId
+}
+
+type ActionData_RequestPause struct {
+	PlayerId uint8 `json:"playerId"`
+}
+
+func (row *ActionData_RequestPause) GetPlayerId() uint8 {
+	return row.PlayerId
+}
+
+type ActionData_Resume struct {
+	PlayerId uint8 `json:"playerId"`
+}
+
+func (row *ActionData_Resume) GetPlayerId() uint8 {
+	return row.PlayerId
+}
+
 type ActionData_AddPlayer struct {
 	SpawnAreaX           uint16 `json:"spawnAreaX"`
 	SpawnAreaY           uint16 `json:"spawnAreaY"`
@@ -120,6 +216,7 @@ type ActionData_AddPlayer struct {
 	WorkerPortX          uint16 `json:"workerPortX"`
 	WorkerPortY          uint16 `json:"workerPortY"`
 	UnpurgeableUnitCount uint8  `json:"unpurgeableUnitCount"`
+	TeamId               uint8  `json:"teamId"`
 }
 
 func (row *ActionData_AddPlayer) GetSpawnAreaX() uint16 {
@@ -150,21 +247,31 @@ func (row *ActionData_AddPlayer) GetUnpurgeableUnitCount() uint8 {
 	return row.UnpurgeableUnitCount
 }
 
+func (row *ActionData_AddPlayer) GetTeamId() uint8 {
+	return row.TeamId
+}
+
 type ActionData_AddUnitPrototype struct {
-	Layer             uint8  `json:"layer"`
-	ResourceCost      uint16 `json:"resourceCost"`
-	ComputeCost       uint8  `json:"computeCost"`
-	SpawnTime         uint8  `json:"spawnTime"`
-	MaxIntegrity      uint8  `json:"maxIntegrity"`
-	LandStrength      uint8  `json:"landStrength"`
-	HoverStrength     uint8  `json:"hoverStrength"`
-	AirStrength       uint8  `json:"airStrength"`
-	AttackRange       uint8  `json:"attackRange"`
-	AttackCooldown    uint8  `json:"attackCooldown"`
-	IsAssault         bool   `json:"isAssault"`
-	IsConfrontational bool   `json:"isConfrontational"`
-	IsWorker          bool   `json:"isWorker"`
-	IsPurgeable       bool   `json:"isPurgeable"`
+	Layer              uint8  `json:"layer"`
+	ResourceCost       uint16 `json:"resourceCost"`
+	ComputeCost        uint8  `json:"computeCost"`
+	SpawnTime          uint8  `json:"spawnTime"`
+	MaxIntegrity       uint8  `json:"maxIntegrity"`
+	LandStrength       uint8  `json:"landStrength"`
+	HoverStrength      uint8  `json:"hoverStrength"`
+	AirStrength        uint8  `json:"airStrength"`
+	AttackRange        uint8  `json:"attackRange"`
+	AttackCooldown     uint8  `json:"attackCooldown"`
+	VisionRadius       uint8  `json:"visionRadius"`
+	SplashRadius       uint8  `json:"splashRadius"`
+	SplashFalloff      uint8  `json:"splashFalloff"`
+	RepairCooldown     uint8  `json:"repairCooldown"`
+	RepairIntegrity    uint8  `json:"repairIntegrity"`
+	RepairResourceCost uint8  `json:"repairResourceCost"`
+	IsAssault          bool   `json:"isAssault"`
+	IsConfrontational  bool   `json:"isConfrontational"`
+	IsWorker           bool   `json:"isWorker"`
+	IsPurgeable        bool   `json:"isPurgeable"`
 }
 
 func (row *ActionData_AddUnitPrototype) GetLayer() uint8 {
@@ -207,6 +314,30 @@ func (row *ActionData_AddUnitPrototype) GetAttackCooldown() uint8 {
 	return row.AttackCooldown
 }
 
+func (row *ActionData_AddUnitPrototype) GetVisionRadius() uint8 {
+	return row.VisionRadius
+}
+
+func (row *ActionData_AddUnitPrototype) GetSplashRadius() uint8 {
+	return row.SplashRadius
+}
+
+func (row *ActionData_AddUnitPrototype) GetSplashFalloff() uint8 {
+	return row.SplashFalloff
+}
+
+func (row *ActionData_AddUnitPrototype) GetRepairCooldown() uint8 {
+	return row.RepairCooldown
+}
+
+func (row *ActionData_AddUnitPrototype) GetRepairIntegrity() uint8 {
+	return row.RepairIntegrity
+}
+
+func (row *ActionData_AddUnitPrototype) GetRepairResourceCost() uint8 {
+	return row.RepairResourceCost
+}
+
 func (row *ActionData_AddUnitPrototype) GetIsAssault() bool {
 	return row.IsAssault
 }
@@ -233,8 +364,10 @@ type ActionData_AddBuildingPrototype struct {
 	MineTime         uint8  `json:"mineTime"`
 	MaxIntegrity     uint8  `json:"maxIntegrity"`
 	BuildingTime     uint8  `json:"buildingTime"`
+	VisionRadius     uint8  `json:"visionRadius"`
 	IsArmory         bool   `json:"isArmory"`
 	IsEnvironment    bool   `json:"isEnvironment"`
+	ResourceReserve  uint16 `json:"resourceReserve"`
 }
 
 func (row *ActionData_AddBuildingPrototype) GetWidth() uint8 {
@@ -273,6 +406,10 @@ func (row *ActionData_AddBuildingPrototype) GetBuildingTime() uint8 {
 	return row.BuildingTime
 }
 
+func (row *ActionData_AddBuildingPrototype) GetVisionRadius() uint8 {
+	return row.VisionRadius
+}
+
 func (row *ActionData_AddBuildingPrototype) GetIsArmory() bool {
 	return row.IsArmory
 }
@@ -280,3 +417,82 @@ func (row *ActionData_AddBuildingPrototype) GetIsArmory() bool {
 func (row *ActionData_AddBuildingPrototype) GetIsEnvironment() bool {
 	return row.IsEnvironment
 }
+
+func (row *ActionData_AddBuildingPrototype) GetResourceReserve() uint16 {
+	return row.ResourceReserve
+}
+
+type ActionData_SetDamage struct {
+	AttackerType uint8 `json:"attackerType"`
+	TargetType   uint8 `json:"targetType"`
+	Strength     uint8 `json:"strength"`
+}
+
+func (row *ActionData_SetDamage) GetAttackerType() uint8 {
+	return row.AttackerType
+}
+
+func (row *ActionData_SetDamage) GetTargetType() uint8 {
+	return row.TargetType
+}
+
+func (row *ActionData_SetDamage) GetStrength() uint8 {
+	return row.Strength
+}
+
+type ActionData_SetMineReserve struct {
+	BuildingId      uint8  `json:"buildingId"`
+	ResourceReserve uint16 `json:"resourceReserve"`
+}
+
+func (row *ActionData_SetMineReserve) GetBuildingId() uint8 {
+	return row.BuildingId
+}
+
+func (row *ActionData_SetMineReserve) GetResourceReserve() uint16 {
+	return row.ResourceReserve
+}
+
+type ActionData_SetTerrain struct {
+	X       uint16 `json:"x"`
+	Y       uint16 `json:"y"`
+	Terrain uint8  `json:"terrain"`
+}
+
+func (row *ActionData_SetTerrain) GetX() uint16 {
+	return row.X
+}
+
+func (row *ActionData_SetTerrain) GetY() uint16 {
+	return row.Y
+}
+
+func (row *ActionData_SetTerrain) GetTerrain() uint8 {
+	return row.Terrain
+}
+
+type ActionData_CancelUnit struct {
+	PlayerId uint8 `json:"playerId"`
+	UnitId   uint8 `json:"unitId"`
+}
+
+func (row *ActionData_CancelUnit) GetPlayerId() uint8 {
+	return row.PlayerId
+}
+
+func (row *ActionData_CancelUnit) GetUnitId() uint8 {
+	return row.UnitId
+}
+
+type ActionData_DemolishBuilding struct {
+	PlayerId   uint8 `json:"playerId"`
+	BuildingId uint8 `json:"buildingId"`
+}
+
+func (row *ActionData_DemolishBuilding) GetPlayerId() uint8 {
+	return row.PlayerId
+}
+
+func (row *ActionData_DemolishBuilding) GetBuildingId() uint8 {
+	return row.BuildingId
+}
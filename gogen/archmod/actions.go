@@ -16,7 +16,11 @@ var ActionSchemasJson = `{
     "initialize": {
         "schema": {
             "width": "uint16",
-            "height": "uint16"
+            "height": "uint16",
+            "maxTicks": "uint32",
+            "ticksPerBlock": "uint8",
+            "demolitionRefundPercent": "uint8",
+            "pathFindNodeBudget": "uint16"
         }
     },
     "start": {
@@ -39,6 +43,18 @@ var ActionSchemasJson = `{
             "commandMeta": "uint8"
         }
     },
+    "assignUnits": {
+        "schema": {
+            "playerId": "uint8",
+            "unitMask0": "uint64",
+            "unitMask1": "uint64",
+            "unitMask2": "uint64",
+            "unitMask3": "uint64",
+            "command": "uint64",
+            "commandExtra": "uint64",
+            "commandMeta": "uint8"
+        }
+    },
     "placeBuilding": {
         "schema": {
             "playerId": "uint8",
@@ -47,6 +63,21 @@ var ActionSchemasJson = `{
             "y": "uint16"
         }
     },
+    "surrender": {
+        "schema": {
+            "playerId": "uint8"
+        }
+    },
+    "requestPause": {
+        "schema": {
+            "playerId": "uint8"
+        }
+    },
+    "resume": {
+        "schema": {
+            "playerId": "uint8"
+        }
+    },
     "addPlayer": {
         "schema": {
             "spawnAreaX": "uint16",
@@ -55,7 +86,8 @@ var ActionSchemasJson = `{
             "spawnAreaHeight": "uint8",
             "workerPortX": "uint16",
             "workerPortY": "uint16",
-            "unpurgeableUnitCount": "uint8"
+            "unpurgeableUnitCount": "uint8",
+            "teamId": "uint8"
         }
     },
     "addUnitPrototype": {
@@ -70,6 +102,12 @@ var ActionSchemasJson = `{
             "airStrength": "uint8",
             "attackRange": "uint8",
             "attackCooldown": "uint8",
+            "visionRadius": "uint8",
+            "splashRadius": "uint8",
+            "splashFalloff": "uint8",
+            "repairCooldown": "uint8",
+            "repairIntegrity": "uint8",
+            "repairResourceCost": "uint8",
             "isAssault": "bool",
             "isConfrontational": "bool",
             "isWorker": "bool",
@@ -87,8 +125,42 @@ var ActionSchemasJson = `{
             "mineTime": "uint8",
             "maxIntegrity": "uint8",
             "buildingTime": "uint8",
+            "visionRadius": "uint8",
             "isArmory": "bool",
-            "isEnvironment": "bool"
+            "isEnvironment": "bool",
+            "resourceReserve": "uint16"
+        }
+    },
+    "setDamage": {
+        "schema": {
+            "attackerType": "uint8",
+            "targetType": "uint8",
+            "strength": "uint8"
+        }
+    },
+    "setMineReserve": {
+        "schema": {
+            "buildingId": "uint8",
+            "resourceReserve": "uint16"
+        }
+    },
+    "setTerrain": {
+        "schema": {
+            "x": "uint16",
+            "y": "uint16",
+            "terrain": "uint8"
+        }
+    },
+    "cancelUnit": {
+        "schema": {
+            "playerId": "uint8",
+            "unitId": "uint8"
+        }
+    },
+    "demolishBuilding": {
+        "schema": {
+            "playerId": "uint8",
+            "buildingId": "uint8"
         }
     }
 }`
@@ -101,10 +173,19 @@ func init() {
 		"Start":                reflect.TypeOf(ActionData_Start{}),
 		"CreateUnit":           reflect.TypeOf(ActionData_CreateUnit{}),
 		"AssignUnit":           reflect.TypeOf(ActionData_AssignUnit{}),
+		"AssignUnits":          reflect.TypeOf(ActionData_AssignUnits{}),
 		"PlaceBuilding":        reflect.TypeOf(ActionData_PlaceBuilding{}),
+		"Surrender":            reflect.TypeOf(ActionData_Surrender{}),
+		"RequestPause":         reflect.TypeOf(ActionData_RequestPause{}),
+		"Resume":               reflect.TypeOf(ActionData_Resume{}),
 		"AddPlayer":            reflect.TypeOf(ActionData_AddPlayer{}),
 		"AddUnitPrototype":     reflect.TypeOf(ActionData_AddUnitPrototype{}),
 		"AddBuildingPrototype": reflect.TypeOf(ActionData_AddBuildingPrototype{}),
+		"SetDamage":            reflect.TypeOf(ActionData_SetDamage{}),
+		"SetMineReserve":       reflect.TypeOf(ActionData_SetMineReserve{}),
+		"SetTerrain":           reflect.TypeOf(ActionData_SetTerrain{}),
+		"CancelUnit":           reflect.TypeOf(ActionData_CancelUnit{}),
+		"DemolishBuilding":     reflect.TypeOf(ActionData_DemolishBuilding{}),
 	}
 	var err error
 	if ActionSchemas, err = arch.NewActionSchemasFromRaw(ActionsABIJson, ActionSchemasJson, types); err != nil {
@@ -117,10 +198,19 @@ type IActions interface {
 	Start(action *ActionData_Start) error
 	CreateUnit(action *ActionData_CreateUnit) error
 	AssignUnit(action *ActionData_AssignUnit) error
+	AssignUnits(action *ActionData_AssignUnits) error
 	PlaceBuilding(action *ActionData_PlaceBuilding) error
+	Surrender(action *ActionData_Surrender) error
+	RequestPause(action *ActionData_RequestPause) error
+	Resume(action *ActionData_Resume) error
 	AddPlayer(action *ActionData_AddPlayer) error
 	AddUnitPrototype(action *ActionData_AddUnitPrototype) error
 	AddBuildingPrototype(action *ActionData_AddBuildingPrototype) error
+	SetDamage(action *ActionData_SetDamage) error
+	SetMineReserve(action *ActionData_SetMineReserve) error
+	SetTerrain(action *ActionData_SetTerrain) error
+	CancelUnit(action *ActionData_CancelUnit) error
+	DemolishBuilding(action *ActionData_DemolishBuilding) error
 	Tick()
 	Purge()
 }
@@ -12,24 +12,36 @@ var (
 
 /*
 Table               KeySize  ValueSize
-Meta                0        13
-Players             1        23
-Board               4        7
+Meta                0        37
+Players             1        27
+Board               4        8
 Units               2        30
-Buildings           2        11
-UnitPrototypes      1        15
-BuildingPrototypes  1        13
+Buildings           2        13
+UnitPrototypes      1        21
+BuildingPrototypes  1        16
+DamageMatrix        2        2
 */
 
 type RowData_Meta struct {
-	BoardWidth             uint16 `json:"boardWidth"`
-	BoardHeight            uint16 `json:"boardHeight"`
-	PlayerCount            uint8  `json:"playerCount"`
-	UnitPrototypeCount     uint8  `json:"unitPrototypeCount"`
-	BuildingPrototypeCount uint8  `json:"buildingPrototypeCount"`
-	IsInitialized          bool   `json:"isInitialized"`
-	HasStarted             bool   `json:"hasStarted"`
-	CreationBlockNumber    uint32 `json:"creationBlockNumber"`
+	BoardWidth              uint16 `json:"boardWidth"`
+	BoardHeight             uint16 `json:"boardHeight"`
+	PlayerCount             uint8  `json:"playerCount"`
+	UnitPrototypeCount      uint8  `json:"unitPrototypeCount"`
+	BuildingPrototypeCount  uint8  `json:"buildingPrototypeCount"`
+	IsInitialized           bool   `json:"isInitialized"`
+	HasStarted              bool   `json:"hasStarted"`
+	CreationBlockNumber     uint32 `json:"creationBlockNumber"`
+	MaxTicks                uint32 `json:"maxTicks"`
+	IsGameOver              bool   `json:"isGameOver"`
+	WinnerId                uint8  `json:"winnerId"`
+	EndTick                 uint32 `json:"endTick"`
+	IsPaused                bool   `json:"isPaused"`
+	PausedBy                uint8  `json:"pausedBy"`
+	PausedTicks             uint32 `json:"pausedTicks"`
+	CurrentPauseTicks       uint32 `json:"currentPauseTicks"`
+	TicksPerBlock           uint8  `json:"ticksPerBlock"`
+	DemolitionRefundPercent uint8  `json:"demolitionRefundPercent"`
+	PathFindNodeBudget      uint16 `json:"pathFindNodeBudget"`
 }
 
 func (row *RowData_Meta) GetBoardWidth() uint16 {
@@ -64,6 +76,50 @@ func (row *RowData_Meta) GetCreationBlockNumber() uint32 {
 	return row.CreationBlockNumber
 }
 
+func (row *RowData_Meta) GetMaxTicks() uint32 {
+	return row.MaxTicks
+}
+
+func (row *RowData_Meta) GetIsGameOver() bool {
+	return row.IsGameOver
+}
+
+func (row *RowData_Meta) GetWinnerId() uint8 {
+	return row.WinnerId
+}
+
+func (row *RowData_Meta) GetEndTick() uint32 {
+	return row.EndTick
+}
+
+func (row *RowData_Meta) GetIsPaused() bool {
+	return row.IsPaused
+}
+
+func (row *RowData_Meta) GetPausedBy() uint8 {
+	return row.PausedBy
+}
+
+func (row *RowData_Meta) GetPausedTicks() uint32 {
+	return row.PausedTicks
+}
+
+func (row *RowData_Meta) GetCurrentPauseTicks() uint32 {
+	return row.CurrentPauseTicks
+}
+
+func (row *RowData_Meta) GetTicksPerBlock() uint8 {
+	return row.TicksPerBlock
+}
+
+func (row *RowData_Meta) GetDemolitionRefundPercent() uint8 {
+	return row.DemolitionRefundPercent
+}
+
+func (row *RowData_Meta) GetPathFindNodeBudget() uint16 {
+	return row.PathFindNodeBudget
+}
+
 type RowData_Players struct {
 	SpawnAreaX                uint16 `json:"spawnAreaX"`
 	SpawnAreaY                uint16 `json:"spawnAreaY"`
@@ -77,11 +133,15 @@ type RowData_Players struct {
 	ComputeSupply             uint8  `json:"computeSupply"`
 	ComputeDemand             uint8  `json:"computeDemand"`
 	UnitCount                 uint8  `json:"unitCount"`
+	LastUnitId                uint8  `json:"lastUnitId"`
 	BuildingCount             uint8  `json:"buildingCount"`
 	BuildingPayQueuePointer   uint8  `json:"buildingPayQueuePointer"`
 	BuildingBuildQueuePointer uint8  `json:"buildingBuildQueuePointer"`
 	UnitPayQueuePointer       uint8  `json:"unitPayQueuePointer"`
 	UnpurgeableUnitCount      uint8  `json:"unpurgeableUnitCount"`
+	TeamId                    uint8  `json:"teamId"`
+	IsEliminated              bool   `json:"isEliminated"`
+	IsPauseRequested          bool   `json:"isPauseRequested"`
 }
 
 func (row *RowData_Players) GetSpawnAreaX() uint16 {
@@ -132,6 +192,10 @@ func (row *RowData_Players) GetUnitCount() uint8 {
 	return row.UnitCount
 }
 
+func (row *RowData_Players) GetLastUnitId() uint8 {
+	return row.LastUnitId
+}
+
 func (row *RowData_Players) GetBuildingCount() uint8 {
 	return row.BuildingCount
 }
@@ -152,6 +216,18 @@ func (row *RowData_Players) GetUnpurgeableUnitCount() uint8 {
 	return row.UnpurgeableUnitCount
 }
 
+func (row *RowData_Players) GetTeamId() uint8 {
+	return row.TeamId
+}
+
+func (row *RowData_Players) GetIsEliminated() bool {
+	return row.IsEliminated
+}
+
+func (row *RowData_Players) GetIsPauseRequested() bool {
+	return row.IsPauseRequested
+}
+
 type RowData_Board struct {
 	LandObjectType uint8 `json:"landObjectType"`
 	LandPlayerId   uint8 `json:"landPlayerId"`
@@ -160,6 +236,7 @@ type RowData_Board struct {
 	HoverUnitId    uint8 `json:"hoverUnitId"`
 	AirPlayerId    uint8 `json:"airPlayerId"`
 	AirUnitId      uint8 `json:"airUnitId"`
+	Terrain        uint8 `json:"terrain"`
 }
 
 func (row *RowData_Board) GetLandObjectType() uint8 {
@@ -190,6 +267,10 @@ func (row *RowData_Board) GetAirUnitId() uint8 {
 	return row.AirUnitId
 }
 
+func (row *RowData_Board) GetTerrain() uint8 {
+	return row.Terrain
+}
+
 type RowData_Units struct {
 	X            uint16 `json:"x"`
 	Y            uint16 `json:"y"`
@@ -249,12 +330,13 @@ func (row *RowData_Units) GetIsPreTicked() bool {
 }
 
 type RowData_Buildings struct {
-	X            uint16 `json:"x"`
-	Y            uint16 `json:"y"`
-	BuildingType uint8  `json:"buildingType"`
-	State        uint8  `json:"state"`
-	Integrity    uint8  `json:"integrity"`
-	Timestamp    uint32 `json:"timestamp"`
+	X               uint16 `json:"x"`
+	Y               uint16 `json:"y"`
+	BuildingType    uint8  `json:"buildingType"`
+	State           uint8  `json:"state"`
+	Integrity       uint8  `json:"integrity"`
+	Timestamp       uint32 `json:"timestamp"`
+	ResourceReserve uint16 `json:"resourceReserve"`
 }
 
 func (row *RowData_Buildings) GetX() uint16 {
@@ -281,21 +363,31 @@ func (row *RowData_Buildings) GetTimestamp() uint32 {
 	return row.Timestamp
 }
 
+func (row *RowData_Buildings) GetResourceReserve() uint16 {
+	return row.ResourceReserve
+}
+
 type RowData_UnitPrototypes struct {
-	Layer             uint8  `json:"layer"`
-	ResourceCost      uint16 `json:"resourceCost"`
-	ComputeCost       uint8  `json:"computeCost"`
-	SpawnTime         uint8  `json:"spawnTime"`
-	MaxIntegrity      uint8  `json:"maxIntegrity"`
-	LandStrength      uint8  `json:"landStrength"`
-	HoverStrength     uint8  `json:"hoverStrength"`
-	AirStrength       uint8  `json:"airStrength"`
-	AttackRange       uint8  `json:"attackRange"`
-	AttackCooldown    uint8  `json:"attackCooldown"`
-	IsAssault         bool   `json:"isAssault"`
-	IsConfrontational bool   `json:"isConfrontational"`
-	IsWorker          bool   `json:"isWorker"`
-	IsPurgeable       bool   `json:"isPurgeable"`
+	Layer              uint8  `json:"layer"`
+	ResourceCost       uint16 `json:"resourceCost"`
+	ComputeCost        uint8  `json:"computeCost"`
+	SpawnTime          uint8  `json:"spawnTime"`
+	MaxIntegrity       uint8  `json:"maxIntegrity"`
+	LandStrength       uint8  `json:"landStrength"`
+	HoverStrength      uint8  `json:"hoverStrength"`
+	AirStrength        uint8  `json:"airStrength"`
+	AttackRange        uint8  `json:"attackRange"`
+	AttackCooldown     uint8  `json:"attackCooldown"`
+	VisionRadius       uint8  `json:"visionRadius"`
+	SplashRadius       uint8  `json:"splashRadius"`
+	SplashFalloff      uint8  `json:"splashFalloff"`
+	RepairCooldown     uint8  `json:"repairCooldown"`
+	RepairIntegrity    uint8  `json:"repairIntegrity"`
+	RepairResourceCost uint8  `json:"repairResourceCost"`
+	IsAssault          bool   `json:"isAssault"`
+	IsConfrontational  bool   `json:"isConfrontational"`
+	IsWorker           bool   `json:"isWorker"`
+	IsPurgeable        bool   `json:"isPurgeable"`
 }
 
 func (row *RowData_UnitPrototypes) GetLayer() uint8 {
@@ -338,6 +430,30 @@ func (row *RowData_UnitPrototypes) GetAttackCooldown() uint8 {
 	return row.AttackCooldown
 }
 
+func (row *RowData_UnitPrototypes) GetVisionRadius() uint8 {
+	return row.VisionRadius
+}
+
+func (row *RowData_UnitPrototypes) GetSplashRadius() uint8 {
+	return row.SplashRadius
+}
+
+func (row *RowData_UnitPrototypes) GetSplashFalloff() uint8 {
+	return row.SplashFalloff
+}
+
+func (row *RowData_UnitPrototypes) GetRepairCooldown() uint8 {
+	return row.RepairCooldown
+}
+
+func (row *RowData_UnitPrototypes) GetRepairIntegrity() uint8 {
+	return row.RepairIntegrity
+}
+
+func (row *RowData_UnitPrototypes) GetRepairResourceCost() uint8 {
+	return row.RepairResourceCost
+}
+
 func (row *RowData_UnitPrototypes) GetIsAssault() bool {
 	return row.IsAssault
 }
@@ -364,8 +480,10 @@ type RowData_BuildingPrototypes struct {
 	MineTime         uint8  `json:"mineTime"`
 	MaxIntegrity     uint8  `json:"maxIntegrity"`
 	BuildingTime     uint8  `json:"buildingTime"`
+	VisionRadius     uint8  `json:"visionRadius"`
 	IsArmory         bool   `json:"isArmory"`
 	IsEnvironment    bool   `json:"isEnvironment"`
+	ResourceReserve  uint16 `json:"resourceReserve"`
 }
 
 func (row *RowData_BuildingPrototypes) GetWidth() uint8 {
@@ -404,6 +522,10 @@ func (row *RowData_BuildingPrototypes) GetBuildingTime() uint8 {
 	return row.BuildingTime
 }
 
+func (row *RowData_BuildingPrototypes) GetVisionRadius() uint8 {
+	return row.VisionRadius
+}
+
 func (row *RowData_BuildingPrototypes) GetIsArmory() bool {
 	return row.IsArmory
 }
@@ -411,3 +533,20 @@ func (row *RowData_BuildingPrototypes) GetIsArmory() bool {
 func (row *RowData_BuildingPrototypes) GetIsEnvironment() bool {
 	return row.IsEnvironment
 }
+
+func (row *RowData_BuildingPrototypes) GetResourceReserve() uint16 {
+	return row.ResourceReserve
+}
+
+type RowData_DamageMatrix struct {
+	Strength uint8 `json:"strength"`
+	IsSet    bool  `json:"isSet"`
+}
+
+func (row *RowData_DamageMatrix) GetStrength() uint8 {
+	return row.Strength
+}
+
+func (row *RowData_DamageMatrix) GetIsSet() bool {
+	return row.IsSet
+}
@@ -23,7 +23,18 @@ var TableSchemasJson = `{
             "buildingPrototypeCount": "uint8",
             "isInitialized": "bool",
             "hasStarted": "bool",
-            "creationBlockNumber": "uint32"
+            "creationBlockNumber": "uint32",
+            "maxTicks": "uint32",
+            "isGameOver": "bool",
+            "winnerId": "uint8",
+            "endTick": "uint32",
+            "isPaused": "bool",
+            "pausedBy": "uint8",
+            "pausedTicks": "uint32",
+            "currentPauseTicks": "uint32",
+            "ticksPerBlock": "uint8",
+            "demolitionRefundPercent": "uint8",
+            "pathFindNodeBudget": "uint16"
         }
     },
     "players": {
@@ -43,11 +54,15 @@ var TableSchemasJson = `{
             "computeSupply": "uint8",
             "computeDemand": "uint8",
             "unitCount": "uint8",
+            "lastUnitId": "uint8",
             "buildingCount": "uint8",
             "buildingPayQueuePointer": "uint8",
             "buildingBuildQueuePointer": "uint8",
             "unitPayQueuePointer": "uint8",
-            "unpurgeableUnitCount": "uint8"
+            "unpurgeableUnitCount": "uint8",
+            "teamId": "uint8",
+            "isEliminated": "bool",
+            "isPauseRequested": "bool"
         }
     },
     "board": {
@@ -62,7 +77,8 @@ var TableSchemasJson = `{
             "hoverPlayerId": "uint8",
             "hoverUnitId": "uint8",
             "airPlayerId": "uint8",
-            "airUnitId": "uint8"
+            "airUnitId": "uint8",
+            "terrain": "uint8"
         }
     },
     "units": {
@@ -95,7 +111,8 @@ var TableSchemasJson = `{
             "buildingType": "uint8",
             "state": "uint8",
             "integrity": "uint8",
-            "timestamp": "uint32"
+            "timestamp": "uint32",
+            "resourceReserve": "uint16"
         }
     },
     "unitPrototypes": {
@@ -113,6 +130,12 @@ var TableSchemasJson = `{
             "airStrength": "uint8",
             "attackRange": "uint8",
             "attackCooldown": "uint8",
+            "visionRadius": "uint8",
+            "splashRadius": "uint8",
+            "splashFalloff": "uint8",
+            "repairCooldown": "uint8",
+            "repairIntegrity": "uint8",
+            "repairResourceCost": "uint8",
             "isAssault": "bool",
             "isConfrontational": "bool",
             "isWorker": "bool",
@@ -133,8 +156,20 @@ var TableSchemasJson = `{
             "mineTime": "uint8",
             "maxIntegrity": "uint8",
             "buildingTime": "uint8",
+            "visionRadius": "uint8",
             "isArmory": "bool",
-            "isEnvironment": "bool"
+            "isEnvironment": "bool",
+            "resourceReserve": "uint16"
+        }
+    },
+    "damageMatrix": {
+        "keySchema": {
+            "attackerType": "uint8",
+            "targetType": "uint8"
+        },
+        "schema": {
+            "strength": "uint8",
+            "isSet": "bool"
         }
     }
 }`
@@ -150,6 +185,7 @@ func init() {
 		"Buildings":          reflect.TypeOf(RowData_Buildings{}),
 		"UnitPrototypes":     reflect.TypeOf(RowData_UnitPrototypes{}),
 		"BuildingPrototypes": reflect.TypeOf(RowData_BuildingPrototypes{}),
+		"DamageMatrix":       reflect.TypeOf(RowData_DamageMatrix{}),
 	}
 	getters := map[string]interface{}{
 		"Meta":               datamod.NewMeta,
@@ -159,6 +195,7 @@ func init() {
 		"Buildings":          datamod.NewBuildings,
 		"UnitPrototypes":     datamod.NewUnitPrototypes,
 		"BuildingPrototypes": datamod.NewBuildingPrototypes,
+		"DamageMatrix":       datamod.NewDamageMatrix,
 	}
 	var err error
 	if TableSchemas, err = arch.NewTableSchemasFromRaw(TablesABIJson, TableSchemasJson, types, getters); err != nil {
@@ -30,12 +30,12 @@ type BoardRow struct {
 }
 
 func NewBoardRow(dsSlot lib.DatastoreSlot) *BoardRow {
-	sizes := []int{1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{1, 1, 1, 1, 1, 1, 1, 1}
 	return &BoardRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, nil, nil)}
 }
 
 func NewBoardRowWithParent(dsSlot lib.DatastoreSlot, parent lib.Parent, rowKey lib.RowKey) *BoardRow {
-	sizes := []int{1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{1, 1, 1, 1, 1, 1, 1, 1}
 	return &BoardRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, parent, rowKey)}
 }
 
@@ -47,6 +47,7 @@ func (v *BoardRow) Get() (
 	hoverUnitId uint8,
 	airPlayerId uint8,
 	airUnitId uint8,
+	terrain uint8,
 ) {
 	return codec.DecodeUint8(1, v.GetField(0)),
 		codec.DecodeUint8(1, v.GetField(1)),
@@ -54,7 +55,8 @@ func (v *BoardRow) Get() (
 		codec.DecodeUint8(1, v.GetField(3)),
 		codec.DecodeUint8(1, v.GetField(4)),
 		codec.DecodeUint8(1, v.GetField(5)),
-		codec.DecodeUint8(1, v.GetField(6))
+		codec.DecodeUint8(1, v.GetField(6)),
+		codec.DecodeUint8(1, v.GetField(7))
 }
 
 func (v *BoardRow) Set(
@@ -65,6 +67,7 @@ func (v *BoardRow) Set(
 	hoverUnitId uint8,
 	airPlayerId uint8,
 	airUnitId uint8,
+	terrain uint8,
 ) {
 	v.SetField(0, codec.EncodeUint8(1, landObjectType))
 	v.SetField(1, codec.EncodeUint8(1, landPlayerId))
@@ -73,6 +76,7 @@ func (v *BoardRow) Set(
 	v.SetField(4, codec.EncodeUint8(1, hoverUnitId))
 	v.SetField(5, codec.EncodeUint8(1, airPlayerId))
 	v.SetField(6, codec.EncodeUint8(1, airUnitId))
+	v.SetField(7, codec.EncodeUint8(1, terrain))
 }
 
 func (v *BoardRow) GetLandObjectType() uint8 {
@@ -145,6 +149,16 @@ func (v *BoardRow) SetAirUnitId(value uint8) {
 	v.SetField(6, data)
 }
 
+func (v *BoardRow) GetTerrain() uint8 {
+	data := v.GetField(7)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *BoardRow) SetTerrain(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(7, data)
+}
+
 type Board struct {
 	dsSlot  lib.DatastoreSlot
 	parent  lib.Parent
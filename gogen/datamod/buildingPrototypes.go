@@ -30,12 +30,12 @@ type BuildingPrototypesRow struct {
 }
 
 func NewBuildingPrototypesRow(dsSlot lib.DatastoreSlot) *BuildingPrototypesRow {
-	sizes := []int{1, 1, 2, 2, 1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{1, 1, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 2}
 	return &BuildingPrototypesRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, nil, nil)}
 }
 
 func NewBuildingPrototypesRowWithParent(dsSlot lib.DatastoreSlot, parent lib.Parent, rowKey lib.RowKey) *BuildingPrototypesRow {
-	sizes := []int{1, 1, 2, 2, 1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{1, 1, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 2}
 	return &BuildingPrototypesRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, parent, rowKey)}
 }
 
@@ -49,8 +49,10 @@ func (v *BuildingPrototypesRow) Get() (
 	mineTime uint8,
 	maxIntegrity uint8,
 	buildingTime uint8,
+	visionRadius uint8,
 	isArmory bool,
 	isEnvironment bool,
+	resourceReserve uint16,
 ) {
 	return codec.DecodeUint8(1, v.GetField(0)),
 		codec.DecodeUint8(1, v.GetField(1)),
@@ -61,8 +63,10 @@ func (v *BuildingPrototypesRow) Get() (
 		codec.DecodeUint8(1, v.GetField(6)),
 		codec.DecodeUint8(1, v.GetField(7)),
 		codec.DecodeUint8(1, v.GetField(8)),
-		codec.DecodeBool(1, v.GetField(9)),
-		codec.DecodeBool(1, v.GetField(10))
+		codec.DecodeUint8(1, v.GetField(9)),
+		codec.DecodeBool(1, v.GetField(10)),
+		codec.DecodeBool(1, v.GetField(11)),
+		codec.DecodeUint16(2, v.GetField(12))
 }
 
 func (v *BuildingPrototypesRow) Set(
@@ -75,8 +79,10 @@ func (v *BuildingPrototypesRow) Set(
 	mineTime uint8,
 	maxIntegrity uint8,
 	buildingTime uint8,
+	visionRadius uint8,
 	isArmory bool,
 	isEnvironment bool,
+	resourceReserve uint16,
 ) {
 	v.SetField(0, codec.EncodeUint8(1, width))
 	v.SetField(1, codec.EncodeUint8(1, height))
@@ -87,8 +93,10 @@ func (v *BuildingPrototypesRow) Set(
 	v.SetField(6, codec.EncodeUint8(1, mineTime))
 	v.SetField(7, codec.EncodeUint8(1, maxIntegrity))
 	v.SetField(8, codec.EncodeUint8(1, buildingTime))
-	v.SetField(9, codec.EncodeBool(1, isArmory))
-	v.SetField(10, codec.EncodeBool(1, isEnvironment))
+	v.SetField(9, codec.EncodeUint8(1, visionRadius))
+	v.SetField(10, codec.EncodeBool(1, isArmory))
+	v.SetField(11, codec.EncodeBool(1, isEnvironment))
+	v.SetField(12, codec.EncodeUint16(2, resourceReserve))
 }
 
 func (v *BuildingPrototypesRow) GetWidth() uint8 {
@@ -181,24 +189,44 @@ func (v *BuildingPrototypesRow) SetBuildingTime(value uint8) {
 	v.SetField(8, data)
 }
 
-func (v *BuildingPrototypesRow) GetIsArmory() bool {
+func (v *BuildingPrototypesRow) GetVisionRadius() uint8 {
 	data := v.GetField(9)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *BuildingPrototypesRow) SetVisionRadius(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(9, data)
+}
+
+func (v *BuildingPrototypesRow) GetIsArmory() bool {
+	data := v.GetField(10)
 	return codec.DecodeBool(1, data)
 }
 
 func (v *BuildingPrototypesRow) SetIsArmory(value bool) {
 	data := codec.EncodeBool(1, value)
-	v.SetField(9, data)
+	v.SetField(10, data)
 }
 
 func (v *BuildingPrototypesRow) GetIsEnvironment() bool {
-	data := v.GetField(10)
+	data := v.GetField(11)
 	return codec.DecodeBool(1, data)
 }
 
 func (v *BuildingPrototypesRow) SetIsEnvironment(value bool) {
 	data := codec.EncodeBool(1, value)
-	v.SetField(10, data)
+	v.SetField(11, data)
+}
+
+func (v *BuildingPrototypesRow) GetResourceReserve() uint16 {
+	data := v.GetField(12)
+	return codec.DecodeUint16(2, data)
+}
+
+func (v *BuildingPrototypesRow) SetResourceReserve(value uint16) {
+	data := codec.EncodeUint16(2, value)
+	v.SetField(12, data)
 }
 
 type BuildingPrototypes struct {
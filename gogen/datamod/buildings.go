@@ -30,12 +30,12 @@ type BuildingsRow struct {
 }
 
 func NewBuildingsRow(dsSlot lib.DatastoreSlot) *BuildingsRow {
-	sizes := []int{2, 2, 1, 1, 1, 4}
+	sizes := []int{2, 2, 1, 1, 1, 4, 2}
 	return &BuildingsRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, nil, nil)}
 }
 
 func NewBuildingsRowWithParent(dsSlot lib.DatastoreSlot, parent lib.Parent, rowKey lib.RowKey) *BuildingsRow {
-	sizes := []int{2, 2, 1, 1, 1, 4}
+	sizes := []int{2, 2, 1, 1, 1, 4, 2}
 	return &BuildingsRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, parent, rowKey)}
 }
 
@@ -46,13 +46,15 @@ func (v *BuildingsRow) Get() (
 	state uint8,
 	integrity uint8,
 	timestamp uint32,
+	resourceReserve uint16,
 ) {
 	return codec.DecodeUint16(2, v.GetField(0)),
 		codec.DecodeUint16(2, v.GetField(1)),
 		codec.DecodeUint8(1, v.GetField(2)),
 		codec.DecodeUint8(1, v.GetField(3)),
 		codec.DecodeUint8(1, v.GetField(4)),
-		codec.DecodeUint32(4, v.GetField(5))
+		codec.DecodeUint32(4, v.GetField(5)),
+		codec.DecodeUint16(2, v.GetField(6))
 }
 
 func (v *BuildingsRow) Set(
@@ -62,6 +64,7 @@ func (v *BuildingsRow) Set(
 	state uint8,
 	integrity uint8,
 	timestamp uint32,
+	resourceReserve uint16,
 ) {
 	v.SetField(0, codec.EncodeUint16(2, x))
 	v.SetField(1, codec.EncodeUint16(2, y))
@@ -69,6 +72,7 @@ func (v *BuildingsRow) Set(
 	v.SetField(3, codec.EncodeUint8(1, state))
 	v.SetField(4, codec.EncodeUint8(1, integrity))
 	v.SetField(5, codec.EncodeUint32(4, timestamp))
+	v.SetField(6, codec.EncodeUint16(2, resourceReserve))
 }
 
 func (v *BuildingsRow) GetX() uint16 {
@@ -131,6 +135,16 @@ func (v *BuildingsRow) SetTimestamp(value uint32) {
 	v.SetField(5, data)
 }
 
+func (v *BuildingsRow) GetResourceReserve() uint16 {
+	data := v.GetField(6)
+	return codec.DecodeUint16(2, data)
+}
+
+func (v *BuildingsRow) SetResourceReserve(value uint16) {
+	data := codec.EncodeUint16(2, value)
+	v.SetField(6, data)
+}
+
 type Buildings struct {
 	dsSlot  lib.DatastoreSlot
 	parent  lib.Parent
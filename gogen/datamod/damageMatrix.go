@@ -0,0 +1,121 @@
+/* Autogenerated file. Do not edit manually. */
+
+package datamod
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/concrete/codegen/datamod/codec"
+	"github.com/ethereum/go-ethereum/concrete/crypto"
+	"github.com/ethereum/go-ethereum/concrete/lib"
+	"github.com/holiman/uint256"
+)
+
+// Reference imports to suppress errors if they are not used.
+var (
+	_ = common.Big1
+	_ = codec.EncodeAddress
+	_ = uint256.NewInt
+)
+
+// var (
+//	DamageMatrixDefaultKey = crypto.Keccak256([]byte("datamod.v1.DamageMatrix"))
+// )
+
+func DamageMatrixDefaultKey() []byte {
+	return crypto.Keccak256([]byte("datamod.v1.DamageMatrix"))
+}
+
+type DamageMatrixRow struct {
+	lib.DatastoreStructWithParent
+}
+
+func NewDamageMatrixRow(dsSlot lib.DatastoreSlot) *DamageMatrixRow {
+	sizes := []int{1, 1}
+	return &DamageMatrixRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, nil, nil)}
+}
+
+func NewDamageMatrixRowWithParent(dsSlot lib.DatastoreSlot, parent lib.Parent, rowKey lib.RowKey) *DamageMatrixRow {
+	sizes := []int{1, 1}
+	return &DamageMatrixRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, parent, rowKey)}
+}
+
+func (v *DamageMatrixRow) Get() (
+	strength uint8,
+	isSet bool,
+) {
+	return codec.DecodeUint8(1, v.GetField(0)),
+		codec.DecodeBool(1, v.GetField(1))
+}
+
+func (v *DamageMatrixRow) Set(
+	strength uint8,
+	isSet bool,
+) {
+	v.SetField(0, codec.EncodeUint8(1, strength))
+	v.SetField(1, codec.EncodeBool(1, isSet))
+}
+
+func (v *DamageMatrixRow) GetStrength() uint8 {
+	data := v.GetField(0)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *DamageMatrixRow) SetStrength(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(0, data)
+}
+
+func (v *DamageMatrixRow) GetIsSet() bool {
+	data := v.GetField(1)
+	return codec.DecodeBool(1, data)
+}
+
+func (v *DamageMatrixRow) SetIsSet(value bool) {
+	data := codec.EncodeBool(1, value)
+	v.SetField(1, data)
+}
+
+type DamageMatrix struct {
+	dsSlot  lib.DatastoreSlot
+	parent  lib.Parent
+	tableId lib.TableId
+}
+
+func NewDamageMatrix(ds lib.Datastore) *DamageMatrix {
+	dsSlot := ds.Get(DamageMatrixDefaultKey())
+	return &DamageMatrix{
+		dsSlot:  dsSlot,
+		parent:  nil,
+		tableId: nil,
+	}
+}
+
+func NewDamageMatrixWithParent(ds lib.Datastore, parent lib.Parent, tableId lib.TableId) *DamageMatrix {
+	t := NewDamageMatrix(ds)
+	t.parent = parent
+	t.tableId = tableId
+	return t
+}
+
+func NewDamageMatrixFromSlot(dsSlot lib.DatastoreSlot) *DamageMatrix {
+	return &DamageMatrix{dsSlot: dsSlot}
+}
+
+func (m *DamageMatrix) Get(
+	attackerType uint8,
+	targetType uint8,
+) *DamageMatrixRow {
+	dsSlot := m.dsSlot.Mapping().GetNested(
+		codec.EncodeUint8(1, attackerType),
+		codec.EncodeUint8(1, targetType),
+	)
+	return NewDamageMatrixRowWithParent(dsSlot, m, lib.RowKey{
+		attackerType, targetType,
+	})
+}
+
+func (m *DamageMatrix) SetFieldCallback(tableId lib.TableId, rowKey lib.RowKey, columnIndex int, value []byte) {
+	if m.parent != nil {
+		m.parent.SetFieldCallback(m.tableId, rowKey, columnIndex, value)
+	}
+}
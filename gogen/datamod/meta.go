@@ -30,12 +30,12 @@ type MetaRow struct {
 }
 
 func NewMetaRow(dsSlot lib.DatastoreSlot) *MetaRow {
-	sizes := []int{2, 2, 1, 1, 1, 1, 1, 4}
+	sizes := []int{2, 2, 1, 1, 1, 1, 1, 4, 4, 1, 1, 4, 1, 1, 4, 4, 1, 1, 2}
 	return &MetaRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, nil, nil)}
 }
 
 func NewMetaRowWithParent(dsSlot lib.DatastoreSlot, parent lib.Parent, rowKey lib.RowKey) *MetaRow {
-	sizes := []int{2, 2, 1, 1, 1, 1, 1, 4}
+	sizes := []int{2, 2, 1, 1, 1, 1, 1, 4, 4, 1, 1, 4, 1, 1, 4, 4, 1, 1, 2}
 	return &MetaRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, parent, rowKey)}
 }
 
@@ -48,6 +48,17 @@ func (v *MetaRow) Get() (
 	isInitialized bool,
 	hasStarted bool,
 	creationBlockNumber uint32,
+	maxTicks uint32,
+	isGameOver bool,
+	winnerId uint8,
+	endTick uint32,
+	isPaused bool,
+	pausedBy uint8,
+	pausedTicks uint32,
+	currentPauseTicks uint32,
+	ticksPerBlock uint8,
+	demolitionRefundPercent uint8,
+	pathFindNodeBudget uint16,
 ) {
 	return codec.DecodeUint16(2, v.GetField(0)),
 		codec.DecodeUint16(2, v.GetField(1)),
@@ -56,7 +67,18 @@ func (v *MetaRow) Get() (
 		codec.DecodeUint8(1, v.GetField(4)),
 		codec.DecodeBool(1, v.GetField(5)),
 		codec.DecodeBool(1, v.GetField(6)),
-		codec.DecodeUint32(4, v.GetField(7))
+		codec.DecodeUint32(4, v.GetField(7)),
+		codec.DecodeUint32(4, v.GetField(8)),
+		codec.DecodeBool(1, v.GetField(9)),
+		codec.DecodeUint8(1, v.GetField(10)),
+		codec.DecodeUint32(4, v.GetField(11)),
+		codec.DecodeBool(1, v.GetField(12)),
+		codec.DecodeUint8(1, v.GetField(13)),
+		codec.DecodeUint32(4, v.GetField(14)),
+		codec.DecodeUint32(4, v.GetField(15)),
+		codec.DecodeUint8(1, v.GetField(16)),
+		codec.DecodeUint8(1, v.GetField(17)),
+		codec.DecodeUint16(2, v.GetField(18))
 }
 
 func (v *MetaRow) Set(
@@ -68,6 +90,17 @@ func (v *MetaRow) Set(
 	isInitialized bool,
 	hasStarted bool,
 	creationBlockNumber uint32,
+	maxTicks uint32,
+	isGameOver bool,
+	winnerId uint8,
+	endTick uint32,
+	isPaused bool,
+	pausedBy uint8,
+	pausedTicks uint32,
+	currentPauseTicks uint32,
+	ticksPerBlock uint8,
+	demolitionRefundPercent uint8,
+	pathFindNodeBudget uint16,
 ) {
 	v.SetField(0, codec.EncodeUint16(2, boardWidth))
 	v.SetField(1, codec.EncodeUint16(2, boardHeight))
@@ -77,6 +110,17 @@ func (v *MetaRow) Set(
 	v.SetField(5, codec.EncodeBool(1, isInitialized))
 	v.SetField(6, codec.EncodeBool(1, hasStarted))
 	v.SetField(7, codec.EncodeUint32(4, creationBlockNumber))
+	v.SetField(8, codec.EncodeUint32(4, maxTicks))
+	v.SetField(9, codec.EncodeBool(1, isGameOver))
+	v.SetField(10, codec.EncodeUint8(1, winnerId))
+	v.SetField(11, codec.EncodeUint32(4, endTick))
+	v.SetField(12, codec.EncodeBool(1, isPaused))
+	v.SetField(13, codec.EncodeUint8(1, pausedBy))
+	v.SetField(14, codec.EncodeUint32(4, pausedTicks))
+	v.SetField(15, codec.EncodeUint32(4, currentPauseTicks))
+	v.SetField(16, codec.EncodeUint8(1, ticksPerBlock))
+	v.SetField(17, codec.EncodeUint8(1, demolitionRefundPercent))
+	v.SetField(18, codec.EncodeUint16(2, pathFindNodeBudget))
 }
 
 func (v *MetaRow) GetBoardWidth() uint16 {
@@ -159,6 +203,116 @@ func (v *MetaRow) SetCreationBlockNumber(value uint32) {
 	v.SetField(7, data)
 }
 
+func (v *MetaRow) GetMaxTicks() uint32 {
+	data := v.GetField(8)
+	return codec.DecodeUint32(4, data)
+}
+
+func (v *MetaRow) SetMaxTicks(value uint32) {
+	data := codec.EncodeUint32(4, value)
+	v.SetField(8, data)
+}
+
+func (v *MetaRow) GetIsGameOver() bool {
+	data := v.GetField(9)
+	return codec.DecodeBool(1, data)
+}
+
+func (v *MetaRow) SetIsGameOver(value bool) {
+	data := codec.EncodeBool(1, value)
+	v.SetField(9, data)
+}
+
+func (v *MetaRow) GetWinnerId() uint8 {
+	data := v.GetField(10)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *MetaRow) SetWinnerId(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(10, data)
+}
+
+func (v *MetaRow) GetEndTick() uint32 {
+	data := v.GetField(11)
+	return codec.DecodeUint32(4, data)
+}
+
+func (v *MetaRow) SetEndTick(value uint32) {
+	data := codec.EncodeUint32(4, value)
+	v.SetField(11, data)
+}
+
+func (v *MetaRow) GetIsPaused() bool {
+	data := v.GetField(12)
+	return codec.DecodeBool(1, data)
+}
+
+func (v *MetaRow) SetIsPaused(value bool) {
+	data := codec.EncodeBool(1, value)
+	v.SetField(12, data)
+}
+
+func (v *MetaRow) GetPausedBy() uint8 {
+	data := v.GetField(13)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *MetaRow) SetPausedBy(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(13, data)
+}
+
+func (v *MetaRow) GetPausedTicks() uint32 {
+	data := v.GetField(14)
+	return codec.DecodeUint32(4, data)
+}
+
+func (v *MetaRow) SetPausedTicks(value uint32) {
+	data := codec.EncodeUint32(4, value)
+	v.SetField(14, data)
+}
+
+func (v *MetaRow) GetCurrentPauseTicks() uint32 {
+	data := v.GetField(15)
+	return codec.DecodeUint32(4, data)
+}
+
+func (v *MetaRow) SetCurrentPauseTicks(value uint32) {
+	data := codec.EncodeUint32(4, value)
+	v.SetField(15, data)
+}
+
+func (v *MetaRow) GetTicksPerBlock() uint8 {
+	data := v.GetField(16)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *MetaRow) SetTicksPerBlock(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(16, data)
+}
+
+func (v *MetaRow) GetDemolitionRefundPercent() uint8 {
+	data := v.GetField(17)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *MetaRow) SetDemolitionRefundPercent(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(17, data)
+}
+
+func (v *MetaRow) GetPathFindNodeBudget() uint16 {
+	data := v.GetField(18)
+	return codec.DecodeUint16(2, data)
+}
+
+func (v *MetaRow) SetPathFindNodeBudget(value uint16) {
+	data := codec.EncodeUint16(2, value)
+	v.SetField(18, data)
+}
+
 type Meta struct {
 	dsSlot  lib.DatastoreSlot
 	parent  lib.Parent
@@ -30,12 +30,12 @@ type PlayersRow struct {
 }
 
 func NewPlayersRow(dsSlot lib.DatastoreSlot) *PlayersRow {
-	sizes := []int{2, 2, 1, 1, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{2, 2, 1, 1, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
 	return &PlayersRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, nil, nil)}
 }
 
 func NewPlayersRowWithParent(dsSlot lib.DatastoreSlot, parent lib.Parent, rowKey lib.RowKey) *PlayersRow {
-	sizes := []int{2, 2, 1, 1, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{2, 2, 1, 1, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
 	return &PlayersRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, parent, rowKey)}
 }
 
@@ -52,11 +52,15 @@ func (v *PlayersRow) Get() (
 	computeSupply uint8,
 	computeDemand uint8,
 	unitCount uint8,
+	lastUnitId uint8,
 	buildingCount uint8,
 	buildingPayQueuePointer uint8,
 	buildingBuildQueuePointer uint8,
 	unitPayQueuePointer uint8,
 	unpurgeableUnitCount uint8,
+	teamId uint8,
+	isEliminated bool,
+	isPauseRequested bool,
 ) {
 	return codec.DecodeUint16(2, v.GetField(0)),
 		codec.DecodeUint16(2, v.GetField(1)),
@@ -74,7 +78,11 @@ func (v *PlayersRow) Get() (
 		codec.DecodeUint8(1, v.GetField(13)),
 		codec.DecodeUint8(1, v.GetField(14)),
 		codec.DecodeUint8(1, v.GetField(15)),
-		codec.DecodeUint8(1, v.GetField(16))
+		codec.DecodeUint8(1, v.GetField(16)),
+		codec.DecodeUint8(1, v.GetField(17)),
+		codec.DecodeUint8(1, v.GetField(18)),
+		codec.DecodeBool(1, v.GetField(19)),
+		codec.DecodeBool(1, v.GetField(20))
 }
 
 func (v *PlayersRow) Set(
@@ -90,11 +98,15 @@ func (v *PlayersRow) Set(
 	computeSupply uint8,
 	computeDemand uint8,
 	unitCount uint8,
+	lastUnitId uint8,
 	buildingCount uint8,
 	buildingPayQueuePointer uint8,
 	buildingBuildQueuePointer uint8,
 	unitPayQueuePointer uint8,
 	unpurgeableUnitCount uint8,
+	teamId uint8,
+	isEliminated bool,
+	isPauseRequested bool,
 ) {
 	v.SetField(0, codec.EncodeUint16(2, spawnAreaX))
 	v.SetField(1, codec.EncodeUint16(2, spawnAreaY))
@@ -108,11 +120,15 @@ func (v *PlayersRow) Set(
 	v.SetField(9, codec.EncodeUint8(1, computeSupply))
 	v.SetField(10, codec.EncodeUint8(1, computeDemand))
 	v.SetField(11, codec.EncodeUint8(1, unitCount))
-	v.SetField(12, codec.EncodeUint8(1, buildingCount))
-	v.SetField(13, codec.EncodeUint8(1, buildingPayQueuePointer))
-	v.SetField(14, codec.EncodeUint8(1, buildingBuildQueuePointer))
-	v.SetField(15, codec.EncodeUint8(1, unitPayQueuePointer))
-	v.SetField(16, codec.EncodeUint8(1, unpurgeableUnitCount))
+	v.SetField(12, codec.EncodeUint8(1, lastUnitId))
+	v.SetField(13, codec.EncodeUint8(1, buildingCount))
+	v.SetField(14, codec.EncodeUint8(1, buildingPayQueuePointer))
+	v.SetField(15, codec.EncodeUint8(1, buildingBuildQueuePointer))
+	v.SetField(16, codec.EncodeUint8(1, unitPayQueuePointer))
+	v.SetField(17, codec.EncodeUint8(1, unpurgeableUnitCount))
+	v.SetField(18, codec.EncodeUint8(1, teamId))
+	v.SetField(19, codec.EncodeBool(1, isEliminated))
+	v.SetField(20, codec.EncodeBool(1, isPauseRequested))
 }
 
 func (v *PlayersRow) GetSpawnAreaX() uint16 {
@@ -235,56 +251,96 @@ func (v *PlayersRow) SetUnitCount(value uint8) {
 	v.SetField(11, data)
 }
 
-func (v *PlayersRow) GetBuildingCount() uint8 {
+func (v *PlayersRow) GetLastUnitId() uint8 {
 	data := v.GetField(12)
 	return codec.DecodeUint8(1, data)
 }
 
-func (v *PlayersRow) SetBuildingCount(value uint8) {
+func (v *PlayersRow) SetLastUnitId(value uint8) {
 	data := codec.EncodeUint8(1, value)
 	v.SetField(12, data)
 }
 
-func (v *PlayersRow) GetBuildingPayQueuePointer() uint8 {
+func (v *PlayersRow) GetBuildingCount() uint8 {
 	data := v.GetField(13)
 	return codec.DecodeUint8(1, data)
 }
 
-func (v *PlayersRow) SetBuildingPayQueuePointer(value uint8) {
+func (v *PlayersRow) SetBuildingCount(value uint8) {
 	data := codec.EncodeUint8(1, value)
 	v.SetField(13, data)
 }
 
-func (v *PlayersRow) GetBuildingBuildQueuePointer() uint8 {
+func (v *PlayersRow) GetBuildingPayQueuePointer() uint8 {
 	data := v.GetField(14)
 	return codec.DecodeUint8(1, data)
 }
 
-func (v *PlayersRow) SetBuildingBuildQueuePointer(value uint8) {
+func (v *PlayersRow) SetBuildingPayQueuePointer(value uint8) {
 	data := codec.EncodeUint8(1, value)
 	v.SetField(14, data)
 }
 
-func (v *PlayersRow) GetUnitPayQueuePointer() uint8 {
+func (v *PlayersRow) GetBuildingBuildQueuePointer() uint8 {
 	data := v.GetField(15)
 	return codec.DecodeUint8(1, data)
 }
 
-func (v *PlayersRow) SetUnitPayQueuePointer(value uint8) {
+func (v *PlayersRow) SetBuildingBuildQueuePointer(value uint8) {
 	data := codec.EncodeUint8(1, value)
 	v.SetField(15, data)
 }
 
-func (v *PlayersRow) GetUnpurgeableUnitCount() uint8 {
+func (v *PlayersRow) GetUnitPayQueuePointer() uint8 {
 	data := v.GetField(16)
 	return codec.DecodeUint8(1, data)
 }
 
-func (v *PlayersRow) SetUnpurgeableUnitCount(value uint8) {
+func (v *PlayersRow) SetUnitPayQueuePointer(value uint8) {
 	data := codec.EncodeUint8(1, value)
 	v.SetField(16, data)
 }
 
+func (v *PlayersRow) GetUnpurgeableUnitCount() uint8 {
+	data := v.GetField(17)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *PlayersRow) SetUnpurgeableUnitCount(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(17, data)
+}
+
+func (v *PlayersRow) GetTeamId() uint8 {
+	data := v.GetField(18)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *PlayersRow) SetTeamId(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(18, data)
+}
+
+func (v *PlayersRow) GetIsEliminated() bool {
+	data := v.GetField(19)
+	return codec.DecodeBool(1, data)
+}
+
+func (v *PlayersRow) SetIsEliminated(value bool) {
+	data := codec.EncodeBool(1, value)
+	v.SetField(19, data)
+}
+
+func (v *PlayersRow) GetIsPauseRequested() bool {
+	data := v.GetField(20)
+	return codec.DecodeBool(1, data)
+}
+
+func (v *PlayersRow) SetIsPauseRequested(value bool) {
+	data := codec.EncodeBool(1, value)
+	v.SetField(20, data)
+}
+
 type Players struct {
 	dsSlot  lib.DatastoreSlot
 	parent  lib.Parent
@@ -30,12 +30,12 @@ type UnitPrototypesRow struct {
 }
 
 func NewUnitPrototypesRow(dsSlot lib.DatastoreSlot) *UnitPrototypesRow {
-	sizes := []int{1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
 	return &UnitPrototypesRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, nil, nil)}
 }
 
 func NewUnitPrototypesRowWithParent(dsSlot lib.DatastoreSlot, parent lib.Parent, rowKey lib.RowKey) *UnitPrototypesRow {
-	sizes := []int{1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	sizes := []int{1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
 	return &UnitPrototypesRow{*lib.NewDatastoreStructWithParent(dsSlot, sizes, parent, rowKey)}
 }
 
@@ -50,6 +50,12 @@ func (v *UnitPrototypesRow) Get() (
 	airStrength uint8,
 	attackRange uint8,
 	attackCooldown uint8,
+	visionRadius uint8,
+	splashRadius uint8,
+	splashFalloff uint8,
+	repairCooldown uint8,
+	repairIntegrity uint8,
+	repairResourceCost uint8,
 	isAssault bool,
 	isConfrontational bool,
 	isWorker bool,
@@ -65,10 +71,16 @@ func (v *UnitPrototypesRow) Get() (
 		codec.DecodeUint8(1, v.GetField(7)),
 		codec.DecodeUint8(1, v.GetField(8)),
 		codec.DecodeUint8(1, v.GetField(9)),
-		codec.DecodeBool(1, v.GetField(10)),
-		codec.DecodeBool(1, v.GetField(11)),
-		codec.DecodeBool(1, v.GetField(12)),
-		codec.DecodeBool(1, v.GetField(13))
+		codec.DecodeUint8(1, v.GetField(10)),
+		codec.DecodeUint8(1, v.GetField(11)),
+		codec.DecodeUint8(1, v.GetField(12)),
+		codec.DecodeUint8(1, v.GetField(13)),
+		codec.DecodeUint8(1, v.GetField(14)),
+		codec.DecodeUint8(1, v.GetField(15)),
+		codec.DecodeBool(1, v.GetField(16)),
+		codec.DecodeBool(1, v.GetField(17)),
+		codec.DecodeBool(1, v.GetField(18)),
+		codec.DecodeBool(1, v.GetField(19))
 }
 
 func (v *UnitPrototypesRow) Set(
@@ -82,6 +94,12 @@ func (v *UnitPrototypesRow) Set(
 	airStrength uint8,
 	attackRange uint8,
 	attackCooldown uint8,
+	visionRadius uint8,
+	splashRadius uint8,
+	splashFalloff uint8,
+	repairCooldown uint8,
+	repairIntegrity uint8,
+	repairResourceCost uint8,
 	isAssault bool,
 	isConfrontational bool,
 	isWorker bool,
@@ -97,10 +115,16 @@ func (v *UnitPrototypesRow) Set(
 	v.SetField(7, codec.EncodeUint8(1, airStrength))
 	v.SetField(8, codec.EncodeUint8(1, attackRange))
 	v.SetField(9, codec.EncodeUint8(1, attackCooldown))
-	v.SetField(10, codec.EncodeBool(1, isAssault))
-	v.SetField(11, codec.EncodeBool(1, isConfrontational))
-	v.SetField(12, codec.EncodeBool(1, isWorker))
-	v.SetField(13, codec.EncodeBool(1, isPurgeable))
+	v.SetField(10, codec.EncodeUint8(1, visionRadius))
+	v.SetField(11, codec.EncodeUint8(1, splashRadius))
+	v.SetField(12, codec.EncodeUint8(1, splashFalloff))
+	v.SetField(13, codec.EncodeUint8(1, repairCooldown))
+	v.SetField(14, codec.EncodeUint8(1, repairIntegrity))
+	v.SetField(15, codec.EncodeUint8(1, repairResourceCost))
+	v.SetField(16, codec.EncodeBool(1, isAssault))
+	v.SetField(17, codec.EncodeBool(1, isConfrontational))
+	v.SetField(18, codec.EncodeBool(1, isWorker))
+	v.SetField(19, codec.EncodeBool(1, isPurgeable))
 }
 
 func (v *UnitPrototypesRow) GetLayer() uint8 {
@@ -203,44 +227,104 @@ func (v *UnitPrototypesRow) SetAttackCooldown(value uint8) {
 	v.SetField(9, data)
 }
 
-func (v *UnitPrototypesRow) GetIsAssault() bool {
+func (v *UnitPrototypesRow) GetVisionRadius() uint8 {
 	data := v.GetField(10)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *UnitPrototypesRow) SetVisionRadius(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(10, data)
+}
+
+func (v *UnitPrototypesRow) GetSplashRadius() uint8 {
+	data := v.GetField(11)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *UnitPrototypesRow) SetSplashRadius(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(11, data)
+}
+
+func (v *UnitPrototypesRow) GetSplashFalloff() uint8 {
+	data := v.GetField(12)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *UnitPrototypesRow) SetSplashFalloff(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(12, data)
+}
+
+func (v *UnitPrototypesRow) GetRepairCooldown() uint8 {
+	data := v.GetField(13)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *UnitPrototypesRow) SetRepairCooldown(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(13, data)
+}
+
+func (v *UnitPrototypesRow) GetRepairIntegrity() uint8 {
+	data := v.GetField(14)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *UnitPrototypesRow) SetRepairIntegrity(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(14, data)
+}
+
+func (v *UnitPrototypesRow) GetRepairResourceCost() uint8 {
+	data := v.GetField(15)
+	return codec.DecodeUint8(1, data)
+}
+
+func (v *UnitPrototypesRow) SetRepairResourceCost(value uint8) {
+	data := codec.EncodeUint8(1, value)
+	v.SetField(15, data)
+}
+
+func (v *UnitPrototypesRow) GetIsAssault() bool {
+	data := v.GetField(16)
 	return codec.DecodeBool(1, data)
 }
 
 func (v *UnitPrototypesRow) SetIsAssault(value bool) {
 	data := codec.EncodeBool(1, value)
-	v.SetField(10, data)
+	v.SetField(16, data)
 }
 
 func (v *UnitPrototypesRow) GetIsConfrontational() bool {
-	data := v.GetField(11)
+	data := v.GetField(17)
 	return codec.DecodeBool(1, data)
 }
 
 func (v *UnitPrototypesRow) SetIsConfrontational(value bool) {
 	data := codec.EncodeBool(1, value)
-	v.SetField(11, data)
+	v.SetField(17, data)
 }
 
 func (v *UnitPrototypesRow) GetIsWorker() bool {
-	data := v.GetField(12)
+	data := v.GetField(18)
 	return codec.DecodeBool(1, data)
 }
 
 func (v *UnitPrototypesRow) SetIsWorker(value bool) {
 	data := codec.EncodeBool(1, value)
-	v.SetField(12, data)
+	v.SetField(18, data)
 }
 
 func (v *UnitPrototypesRow) GetIsPurgeable() bool {
-	data := v.GetField(13)
+	data := v.GetField(19)
 	return codec.DecodeBool(1, data)
 }
 
 func (v *UnitPrototypesRow) SetIsPurgeable(value bool) {
 	data := codec.EncodeBool(1, value)
-	v.SetField(13, data)
+	v.SetField(19, data)
 }
 
 type UnitPrototypes struct {
@@ -0,0 +1,244 @@
+package rts
+
+import (
+	"image"
+	"testing"
+
+	"github.com/concrete-eth/archetype/arch"
+	"github.com/concrete-eth/archetype/kvstore"
+	"github.com/concrete-eth/archetype/precompile"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/concrete/api"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/holiman/uint256"
+)
+
+const (
+	royaleProtoId_AntiAir uint8 = iota + 1
+	royaleProtoId_Air
+	royaleProtoId_Tank
+	royaleProtoId_Worker
+	royaleProtoId_Turret
+)
+
+const (
+	royaleProtoId_Main uint8 = iota + 1
+	royaleProtoId_Pit
+	royaleProtoId_Mine
+)
+
+// Blocks run after the royale genesis so the armies are engaged when the benchmarked block runs.
+const royaleWarmupBlocks = 8
+
+// royaleGenesis returns the actions that set up the royale board as sol/Game.sol and
+// sol/solgen/BoardLib.sol do, with a genesis army of 16 fighters per player attack-moving towards
+// the enemy main building.
+func royaleGenesis() []arch.Action {
+	actions := []arch.Action{
+		&UnitPrototypeAddition{
+			Layer: uint8(LayerId_Land), ResourceCost: 150, ComputeCost: 1, SpawnTime: 4, MaxIntegrity: 100,
+			LandStrength: 5, HoverStrength: 10, AirStrength: 15, AttackCooldown: 3, AttackRange: 4, VisionRadius: 5,
+			IsConfrontational: true, IsPurgeable: true,
+		},
+		&UnitPrototypeAddition{
+			Layer: uint8(LayerId_Air), ResourceCost: 100, ComputeCost: 1, SpawnTime: 2, MaxIntegrity: 25,
+			LandStrength: 5, AirStrength: 3, AttackCooldown: 2, AttackRange: 2, VisionRadius: 4,
+			IsAssault: true, IsConfrontational: true, IsPurgeable: true,
+		},
+		&UnitPrototypeAddition{
+			Layer: uint8(LayerId_Land), ResourceCost: 300, ComputeCost: 1, SpawnTime: 8, MaxIntegrity: 150,
+			LandStrength: 10, AirStrength: 3, AttackCooldown: 4, AttackRange: 3, VisionRadius: 4,
+			SplashRadius: 1, SplashFalloff: 50, IsPurgeable: true,
+		},
+		&UnitPrototypeAddition{
+			Layer: uint8(LayerId_Hover), MaxIntegrity: 1, VisionRadius: 2,
+			RepairCooldown: 2, RepairIntegrity: 5, RepairResourceCost: 5,
+			IsConfrontational: true, IsWorker: true, IsPurgeable: true,
+		},
+		&UnitPrototypeAddition{
+			Layer: uint8(LayerId_Land), ResourceCost: 300, SpawnTime: 8, MaxIntegrity: 150,
+			LandStrength: 3, AirStrength: 3, AttackCooldown: 1, AttackRange: 3, VisionRadius: 4,
+			IsConfrontational: true, IsPurgeable: true,
+		},
+		&BuildingPrototypeAddition{
+			Width: 2, Height: 2, ResourceCapacity: 300, ComputeCapacity: 8, MaxIntegrity: 250, VisionRadius: 4,
+		},
+		&BuildingPrototypeAddition{Width: 1, Height: 1, IsEnvironment: true},
+		&BuildingPrototypeAddition{Width: 1, Height: 1, ResourceMine: 25, IsEnvironment: true},
+		&BuildingPrototypeAddition{
+			Width: 2, Height: 2, ResourceCost: 100, ResourceCapacity: 300, MaxIntegrity: 100, BuildingTime: 8, VisionRadius: 2,
+		},
+		&BuildingPrototypeAddition{
+			Width: 2, Height: 2, ResourceCost: 150, ComputeCapacity: 4, MaxIntegrity: 100, BuildingTime: 12, VisionRadius: 2,
+		},
+		&BuildingPrototypeAddition{
+			Width: 2, Height: 2, ResourceCost: 200, MaxIntegrity: 150, BuildingTime: 16, VisionRadius: 2, IsArmory: true,
+		},
+		&Initialization{Width: 15, Height: 8, MaxTicks: 1800, TicksPerBlock: 1, DemolitionRefundPercent: 50},
+	}
+
+	for _, y := range []uint16{0, 2, 3, 4, 5, 7} {
+		actions = append(actions, &BuildingPlacement{PlayerId: 0, BuildingType: royaleProtoId_Pit, X: 7, Y: y})
+	}
+	actions = append(actions,
+		&BuildingPlacement{PlayerId: 0, BuildingType: royaleProtoId_Mine, X: 0, Y: 2},
+		&BuildingPlacement{PlayerId: 0, BuildingType: royaleProtoId_Mine, X: 14, Y: 2},
+	)
+
+	actions = append(actions,
+		&PlayerAddition{SpawnAreaX: 2, SpawnAreaWidth: 5, SpawnAreaHeight: 8, WorkerPortX: 0, WorkerPortY: 3, UnpurgeableUnitCount: 3},
+		&BuildingPlacement{PlayerId: 1, BuildingType: royaleProtoId_Main, X: 0, Y: 3},
+		&UnitCreation{PlayerId: 1, UnitType: royaleProtoId_Worker, X: 2, Y: 3},
+		&UnitAssignation{PlayerId: 1, UnitId: 1, Command: 65543},
+		&UnitCreation{PlayerId: 1, UnitType: royaleProtoId_Turret, X: 2, Y: 1},
+		&UnitAssignation{PlayerId: 1, UnitId: 2, Command: 131073},
+		&UnitCreation{PlayerId: 1, UnitType: royaleProtoId_Turret, X: 2, Y: 6},
+		&UnitAssignation{PlayerId: 1, UnitId: 3, Command: 131078},
+		&PlayerAddition{SpawnAreaX: 8, SpawnAreaWidth: 5, SpawnAreaHeight: 8, WorkerPortX: 14, WorkerPortY: 3, UnpurgeableUnitCount: 3},
+		&BuildingPlacement{PlayerId: 2, BuildingType: royaleProtoId_Main, X: 13, Y: 3},
+		&UnitCreation{PlayerId: 2, UnitType: royaleProtoId_Worker, X: 12, Y: 3},
+		&UnitAssignation{PlayerId: 2, UnitId: 1, Command: 65544},
+		&UnitCreation{PlayerId: 2, UnitType: royaleProtoId_Turret, X: 12, Y: 1},
+		&UnitAssignation{PlayerId: 2, UnitId: 2, Command: 786433},
+		&UnitCreation{PlayerId: 2, UnitType: royaleProtoId_Turret, X: 12, Y: 6},
+		&UnitAssignation{PlayerId: 2, UnitId: 3, Command: 786438},
+	)
+
+	armyProtoIds := []uint8{royaleProtoId_AntiAir, royaleProtoId_Air, royaleProtoId_Tank}
+	armyColumns := map[uint8][]uint16{1: {4, 5}, 2: {9, 10}}
+	enemyMainPositions := map[uint8]image.Point{1: {13, 3}, 2: {0, 3}}
+	for playerId := uint8(1); playerId <= 2; playerId++ {
+		command := NewFighterCommandData(FighterCommandType_AttackMove)
+		command.SetTargetPosition(enemyMainPositions[playerId])
+		unitId := uint8(4)
+		for _, x := range armyColumns[playerId] {
+			for y := uint16(0); y < 8; y++ {
+				protoId := armyProtoIds[int(unitId)%len(armyProtoIds)]
+				actions = append(actions,
+					&UnitCreation{PlayerId: playerId, UnitType: protoId, X: x, Y: y},
+					&UnitAssignation{PlayerId: playerId, UnitId: unitId, Command: command.Uint64()},
+				)
+				unitId++
+			}
+		}
+	}
+
+	return append(actions, &Start{})
+}
+
+type benchCore interface {
+	arch.Core
+	archmod.IActions
+}
+
+// uncachedCore runs ticks without the row cache so benchmarks can measure what it saves.
+type uncachedCore struct {
+	*Core
+}
+
+func newUncachedCore() benchCore {
+	return uncachedCore{&Core{}}
+}
+
+func (c uncachedCore) Tick() {
+	c.tick()
+}
+
+// benchmarkClientTick measures a block of ticks run by a core over an in-memory store, as the
+// wasm client runs it. Run with GOOS=js GOARCH=wasm to measure the wasm build.
+func benchmarkClientTick(b *testing.B, c benchCore) {
+	kv := kvstore.NewStagedKeyValueStore(kvstore.NewMemoryKeyValueStore())
+	c.SetKV(kv)
+	for _, action := range royaleGenesis() {
+		if err := archmod.ActionSchemas.ExecuteAction(action, c); err != nil {
+			b.Fatal(err)
+		}
+	}
+	for i := 0; i < royaleWarmupBlocks; i++ {
+		c.SetBlockNumber(c.BlockNumber() + 1)
+		arch.RunBlockTicks(c)
+	}
+	kv.Commit()
+	c.SetBlockNumber(c.BlockNumber() + 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		arch.RunBlockTicks(c)
+		b.StopTimer()
+		kv.Revert()
+		b.StartTimer()
+	}
+}
+
+func BenchmarkClientTick(b *testing.B) {
+	b.Run("RowCache", func(b *testing.B) { benchmarkClientTick(b, &Core{}) })
+	b.Run("NoRowCache", func(b *testing.B) { benchmarkClientTick(b, newUncachedCore()) })
+}
+
+// BenchmarkPrecompileTick measures a block of ticks run through the core precompile over a
+// metered environment and reports the gas it uses.
+func BenchmarkPrecompileTick(b *testing.B) {
+	var (
+		schemas  = arch.ArchSchemas{Actions: archmod.ActionSchemas, Tables: archmod.TableSchemas}
+		pc       = precompile.NewCorePrecompile(schemas, func() arch.Core { return &Core{} })
+		db       = state.NewDatabase(rawdb.NewMemoryDatabase())
+		blockCtx = api.NewMockBlockContext()
+		contract = &api.Contract{
+			Address:  common.HexToAddress("0x80"),
+			GasPrice: uint256.NewInt(0),
+			Value:    uint256.NewInt(0),
+		}
+	)
+
+	run := func(env *api.Env, action arch.Action) uint64 {
+		input, err := archmod.ActionSchemas.ActionToCalldata(action)
+		if err != nil {
+			b.Fatal(err)
+		}
+		contract.Gas = 1 << 40
+		if _, err := pc.Run(env, input); err != nil {
+			b.Fatal(err)
+		}
+		return 1<<40 - contract.Gas
+	}
+
+	statedb, err := state.New(common.Hash{}, db, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	env, _, _, _ := api.NewMockEnvironment(api.WithStateDB(statedb), api.WithBlockCtx(blockCtx), api.WithContract(contract))
+	for _, action := range royaleGenesis() {
+		run(env, action)
+	}
+	for i := 0; i < royaleWarmupBlocks; i++ {
+		blockCtx.SetBlockNumber(blockCtx.BlockNumber() + 1)
+		run(env, &arch.CanonicalTickAction{})
+	}
+	blockCtx.SetBlockNumber(blockCtx.BlockNumber() + 1)
+
+	// Reopen the state so the benchmarked block starts with a cold access list, as a new
+	// transaction would
+	root, err := statedb.Commit(0, false)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if statedb, err = state.New(root, db, nil); err != nil {
+		b.Fatal(err)
+	}
+	env, _, _, _ = api.NewMockEnvironment(
+		api.WithStateDB(statedb), api.WithBlockCtx(blockCtx), api.WithContract(contract), api.WithMeterGas(true),
+	)
+
+	var gasUsed uint64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		snapshot := statedb.Snapshot()
+		gasUsed += run(env, &arch.CanonicalTickAction{})
+		b.StopTimer()
+		statedb.RevertToSnapshot(snapshot)
+		b.StartTimer()
+	}
+	b.ReportMetric(float64(gasUsed)/float64(b.N), "gas/op")
+}
@@ -8,6 +8,7 @@ const (
 	BuildingState_Building
 	BuildingState_Built
 	BuildingState_Destroyed
+	BuildingState_Depleted // Mine with no resources left
 	BuildingState_Count
 )
 
@@ -24,5 +25,5 @@ func (s BuildingState) IsActive() bool {
 }
 
 func (s BuildingState) HasBeenBuilt() bool {
-	return s == BuildingState_Built || s == BuildingState_Destroyed
+	return s == BuildingState_Built || s == BuildingState_Destroyed || s == BuildingState_Depleted
 }
@@ -0,0 +1,40 @@
+package rts
+
+import (
+	"github.com/concrete-eth/ark-royale/gogen/datamod"
+)
+
+// rowCache holds the rows fetched during a tick so repeated lookups of the same row reuse the
+// same handle instead of building a new one over the datastore.
+// Rows read and write through to the datastore, so cached handles never go stale while the
+// datastore stays the same. The cache is dropped at the end of every tick.
+// It saves the time and allocations spent building rows, not storage reads: on chain the core
+// precompile already serves repeated reads of a slot from its own cache, so gas is unchanged.
+type rowCache struct {
+	meta           *datamod.MetaRow
+	players        map[uint8]*datamod.PlayersRow
+	units          map[uint16]*datamod.UnitsRow
+	unitPrototypes map[uint8]*datamod.UnitPrototypesRow
+}
+
+func newRowCache() *rowCache {
+	return &rowCache{
+		players:        make(map[uint8]*datamod.PlayersRow),
+		units:          make(map[uint16]*datamod.UnitsRow),
+		unitPrototypes: make(map[uint8]*datamod.UnitPrototypesRow),
+	}
+}
+
+func unitRowCacheKey(playerId uint8, unitId uint8) uint16 {
+	return uint16(playerId)<<8 | uint16(unitId)
+}
+
+// Enables the row cache until the returned function is called.
+func (c *Core) enableRowCache() func() {
+	if c.rowCache != nil {
+		// Already enabled by the caller
+		return func() {}
+	}
+	c.rowCache = newRowCache()
+	return func() { c.rowCache = nil }
+}
@@ -0,0 +1,125 @@
+package rts
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	"github.com/concrete-eth/archetype/arch"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// RowReader reads a row from a table given its keys in table key order.
+// The returned row must be a struct (or a pointer to one) with the table columns as fields in
+// column order, e.g., archmod.RowData_Units or the equivalent ABI binding struct.
+type RowReader func(tableId arch.ValidTableId, keys ...interface{}) (interface{}, error)
+
+// ComputeStateHash computes the canonical hash of the Meta, Players, Board, Units and Buildings tables.
+// Rows are hashed in table order and then in ascending key order. Players and their objects are
+// hashed from id 0 so environment buildings are included.
+// Each row is chained into the hash as keccak256(abi.encode(hash, row)) starting from the zero hash,
+// so the game contract can compute the same hash on chain in a single call.
+func ComputeStateHash(read RowReader) (common.Hash, error) {
+	var (
+		hasher = crypto.NewKeccakState()
+		hash   common.Hash
+	)
+	write := func(tableId arch.ValidTableId, keys ...interface{}) (reflect.Value, error) {
+		row, err := read(tableId, keys...)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		rowVal := reflect.Indirect(reflect.ValueOf(row))
+		hasher.Reset()
+		hasher.Write(hash[:])
+		if err := writeRow(hasher, rowVal); err != nil {
+			return reflect.Value{}, err
+		}
+		hasher.Read(hash[:])
+		return rowVal, nil
+	}
+
+	meta, err := write(TableId_Meta)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	var (
+		boardWidth  = uint16(meta.FieldByName("BoardWidth").Uint())
+		boardHeight = uint16(meta.FieldByName("BoardHeight").Uint())
+		playerCount = uint8(meta.FieldByName("PlayerCount").Uint())
+	)
+
+	players := make([]reflect.Value, playerCount+1)
+	for playerId := uint8(0); playerId <= playerCount; playerId++ {
+		if players[playerId], err = write(TableId_Players, playerId); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	for x := uint16(0); x < boardWidth; x++ {
+		for y := uint16(0); y < boardHeight; y++ {
+			if _, err := write(TableId_Board, x, y); err != nil {
+				return common.Hash{}, err
+			}
+		}
+	}
+	for playerId := uint8(0); playerId <= playerCount; playerId++ {
+		unitCount := uint8(players[playerId].FieldByName("UnitCount").Uint())
+		for i := 1; i <= int(unitCount); i++ {
+			unitId := uint8(i)
+			if _, err := write(TableId_Units, playerId, unitId); err != nil {
+				return common.Hash{}, err
+			}
+		}
+	}
+	for playerId := uint8(0); playerId <= playerCount; playerId++ {
+		buildingCount := uint8(players[playerId].FieldByName("BuildingCount").Uint())
+		for buildingId := uint8(1); buildingId <= buildingCount; buildingId++ {
+			if _, err := write(TableId_Buildings, playerId, buildingId); err != nil {
+				return common.Hash{}, err
+			}
+		}
+	}
+
+	return hash, nil
+}
+
+// writeRow writes every field of the row ABI encoded, i.e., as a big endian 32 byte word.
+func writeRow(hasher crypto.KeccakState, row reflect.Value) error {
+	if row.Kind() != reflect.Struct {
+		return fmt.Errorf("row is not a struct: %v", row.Type())
+	}
+	buf := make([]byte, 32*row.NumField())
+	for i := 0; i < row.NumField(); i++ {
+		field := row.Field(i)
+		word := buf[32*i : 32*(i+1)]
+		switch field.Kind() {
+		case reflect.Bool:
+			if field.Bool() {
+				word[31] = 1
+			}
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			binary.BigEndian.PutUint64(word[24:], field.Uint())
+		default:
+			return fmt.Errorf("unsupported field type: %v", field.Type())
+		}
+	}
+	hasher.Write(buf)
+	return nil
+}
+
+// StateHash returns the canonical hash of the current game state. See ComputeStateHash.
+// The hash is computed on demand rather than inside Tick, as it reads every row of the hashed
+// tables; the headless client computes it after every block it applies and compares it against
+// the hash the game contract computes at the same block.
+func (c *Core) StateHash() common.Hash {
+	hash, err := ComputeStateHash(func(tableId arch.ValidTableId, keys ...interface{}) (interface{}, error) {
+		return archmod.TableSchemas.Read(c.Datastore(), tableId, keys...)
+	})
+	if err != nil {
+		// Local rows always match the table schemas
+		panic(err)
+	}
+	return hash
+}
@@ -0,0 +1,91 @@
+package rts
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/concrete-eth/archetype/arch"
+	tables_contract "github.com/concrete-eth/ark-royale/gogen/abigen/tables"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestStateHash(t *testing.T) {
+	a := newTestCore(t, 4, 4)
+	b := newTestCore(t, 4, 4)
+	if a.StateHash() != b.StateHash() {
+		t.Fatalf("expected equal hashes for equal states")
+	}
+
+	SetTileUnit(a.GetBoardTile(1, 2), LayerId_Hover, 1, 1)
+	if a.StateHash() == b.StateHash() {
+		t.Fatalf("expected different hashes for different states")
+	}
+
+	SetTileUnit(b.GetBoardTile(1, 2), LayerId_Hover, 1, 1)
+	if a.StateHash() != b.StateHash() {
+		t.Errorf("expected equal hashes for equal states")
+	}
+
+	// Board rows are hashed in a fixed order, the same tile change elsewhere must not collide
+	c := newTestCore(t, 4, 4)
+	SetTileUnit(c.GetBoardTile(2, 1), LayerId_Hover, 1, 1)
+	if a.StateHash() == c.StateHash() {
+		t.Errorf("expected different hashes for different states")
+	}
+}
+
+// The game contract chains rows into the hash with keccak256(abi.encode(hash, row)) using the table
+// row structs, so hashing the binding rows with the ABI encoder must give the local hash.
+func TestStateHashAbiEncoding(t *testing.T) {
+	bindingTypes := map[string]reflect.Type{
+		"Meta":      reflect.TypeOf(tables_contract.RowDataMeta{}),
+		"Players":   reflect.TypeOf(tables_contract.RowDataPlayers{}),
+		"Board":     reflect.TypeOf(tables_contract.RowDataBoard{}),
+		"Units":     reflect.TypeOf(tables_contract.RowDataUnits{}),
+		"Buildings": reflect.TypeOf(tables_contract.RowDataBuildings{}),
+	}
+	tablesAbi, err := tables_contract.ContractMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bytes32Type, err := abi.NewType("bytes32", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := newTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 1, Y: 1}))
+	startTestMatch(t, c, 4)
+	var abiHash common.Hash
+	hash, err := ComputeStateHash(func(tableId arch.ValidTableId, keys ...interface{}) (interface{}, error) {
+		row, err := archmod.TableSchemas.Read(c.Datastore(), tableId, keys...)
+		if err != nil {
+			return nil, err
+		}
+		name := archmod.TableSchemas.GetTableSchema(tableId).Name
+		bindingRow := reflect.New(bindingTypes[name]).Elem()
+		rowVal := reflect.Indirect(reflect.ValueOf(row))
+		for i := 0; i < rowVal.NumField(); i++ {
+			bindingRow.FieldByName(rowVal.Type().Field(i).Name).Set(rowVal.Field(i))
+		}
+		args := abi.Arguments{{Type: bytes32Type}, {Type: tablesAbi.Methods["get"+name+"Row"].Outputs[0].Type}}
+		data, err := args.Pack(abiHash, bindingRow.Interface())
+		if err != nil {
+			return nil, err
+		}
+		abiHash = crypto.Keccak256Hash(data)
+		return row, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != abiHash {
+		t.Errorf("expected the ABI encoded rows to hash to %v, got %v", hash, abiHash)
+	}
+	if hash != c.StateHash() {
+		t.Errorf("expected state hash %v, got %v", c.StateHash(), hash)
+	}
+}
@@ -0,0 +1,539 @@
+package rts
+
+import (
+	"fmt"
+	"image"
+	"math/rand"
+	"testing"
+
+	"github.com/concrete-eth/archetype/arch"
+	"github.com/concrete-eth/archetype/kvstore"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/concrete-eth/ark-royale/gogen/datamod"
+)
+
+const (
+	fuzzProtoId_Main uint8 = iota + 1
+	fuzzProtoId_Armory
+	fuzzProtoId_Storage
+	fuzzProtoId_Mine
+)
+
+const (
+	fuzzProtoId_Worker uint8 = iota + 1
+	fuzzProtoId_Fighter
+	fuzzProtoId_Flyer
+	fuzzProtoId_Artillery
+)
+
+// fuzzMatch plays random actions against a core and checks the core invariants after every tick.
+// Actions run on a staged store that is reverted when they fail, as a reverted transaction would.
+type fuzzMatch struct {
+	t    *testing.T
+	c    *Core
+	kv   *kvstore.StagedKeyValueStore
+	rng  *rand.Rand
+	seed int64
+
+	buildingPayPointers   map[uint8]uint8
+	buildingBuildPointers map[uint8]uint8
+	unitPayPointers       map[uint8]uint8
+}
+
+func newFuzzMatch(t *testing.T, seed int64) *fuzzMatch {
+	kv := kvstore.NewStagedKeyValueStore(kvstore.NewMemoryKeyValueStore())
+	c := &Core{}
+	c.SetKV(kv)
+	m := &fuzzMatch{
+		t:                     t,
+		c:                     c,
+		kv:                    kv,
+		rng:                   rand.New(rand.NewSource(seed)),
+		seed:                  seed,
+		buildingPayPointers:   make(map[uint8]uint8),
+		buildingBuildPointers: make(map[uint8]uint8),
+		unitPayPointers:       make(map[uint8]uint8),
+	}
+
+	m.mustNotFail(c.Initialize(&Initialization{Width: 24, Height: 12, TicksPerBlock: 2, DemolitionRefundPercent: 50}))
+	// A lake only hover and air units cross, bordered by rough ground hover units cannot cross
+	for x := uint16(10); x < 14; x++ {
+		for y := uint16(3); y < 9; y++ {
+			terrain := TerrainType_Water
+			if x == 10 || x == 13 {
+				terrain = TerrainType_Rough
+			}
+			m.mustNotFail(c.SetTerrain(&TerrainSetting{X: x, Y: y, Terrain: terrain.Uint8()}))
+		}
+	}
+	m.mustNotFail(c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 2, Height: 2, ResourceCapacity: 300, ComputeCapacity: 8, MaxIntegrity: 120, VisionRadius: 3,
+	}))
+	m.mustNotFail(c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 2, Height: 2, ResourceCost: 120, ComputeCapacity: 6, MaxIntegrity: 40, BuildingTime: 6, VisionRadius: 2, IsArmory: true,
+	}))
+	m.mustNotFail(c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 1, Height: 1, ResourceCost: 60, ResourceCapacity: 200, MaxIntegrity: 30, BuildingTime: 4, VisionRadius: 1,
+	}))
+	m.mustNotFail(c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 1, Height: 1, ResourceMine: 20, MineTime: 3, MaxIntegrity: 255, IsEnvironment: true,
+	}))
+	m.mustNotFail(c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Hover), ResourceCost: 40, ComputeCost: 1, SpawnTime: 3, MaxIntegrity: 10, VisionRadius: 2,
+		RepairCooldown: 2, RepairIntegrity: 5, RepairResourceCost: 5, IsWorker: true,
+	}))
+	m.mustNotFail(c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), ResourceCost: 50, ComputeCost: 2, SpawnTime: 4, MaxIntegrity: 20,
+		LandStrength: 4, HoverStrength: 2, AttackRange: 1, AttackCooldown: 2, VisionRadius: 3,
+		IsConfrontational: true, IsPurgeable: true,
+	}))
+	m.mustNotFail(c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Air), ResourceCost: 70, ComputeCost: 3, SpawnTime: 5, MaxIntegrity: 15,
+		LandStrength: 2, HoverStrength: 3, AirStrength: 4, AttackRange: 2, AttackCooldown: 3, VisionRadius: 4,
+		IsPurgeable: true,
+	}))
+	m.mustNotFail(c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), ResourceCost: 90, ComputeCost: 3, SpawnTime: 6, MaxIntegrity: 12,
+		LandStrength: 6, HoverStrength: 6, AttackRange: 3, AttackCooldown: 5, VisionRadius: 4,
+		SplashRadius: 1, SplashFalloff: 50, IsAssault: true, IsPurgeable: true,
+	}))
+	m.mustNotFail(c.AddPlayer(&PlayerAddition{
+		SpawnAreaX: 2, SpawnAreaY: 0, SpawnAreaWidth: 5, SpawnAreaHeight: 12, WorkerPortX: 2, WorkerPortY: 5, UnpurgeableUnitCount: 2,
+	}))
+	m.mustNotFail(c.AddPlayer(&PlayerAddition{
+		SpawnAreaX: 17, SpawnAreaY: 0, SpawnAreaWidth: 5, SpawnAreaHeight: 12, WorkerPortX: 21, WorkerPortY: 5, UnpurgeableUnitCount: 2,
+	}))
+	m.mustNotFail(c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: fuzzProtoId_Main, X: 0, Y: 5}))
+	m.mustNotFail(c.PlaceBuilding(&BuildingPlacement{PlayerId: 2, BuildingType: fuzzProtoId_Main, X: 22, Y: 5}))
+	for _, position := range []image.Point{{11, 1}, {12, 10}, {8, 6}, {15, 5}} {
+		m.mustNotFail(c.PlaceBuilding(&BuildingPlacement{
+			PlayerId: NilPlayerId, BuildingType: fuzzProtoId_Mine, X: uint16(position.X), Y: uint16(position.Y),
+		}))
+	}
+	// Limit the two mines closest to the main buildings so workers run them dry
+	m.mustNotFail(c.SetMineReserve(&MineReserveSetting{BuildingId: 3, ResourceReserve: 40}))
+	m.mustNotFail(c.SetMineReserve(&MineReserveSetting{BuildingId: 4, ResourceReserve: 40}))
+	m.mustNotFail(c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: fuzzProtoId_Worker, X: 2, Y: 4}))
+	m.mustNotFail(c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: fuzzProtoId_Worker, X: 2, Y: 7}))
+	m.mustNotFail(c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: fuzzProtoId_Worker, X: 21, Y: 4}))
+	m.mustNotFail(c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: fuzzProtoId_Worker, X: 21, Y: 7}))
+	m.mustNotFail(c.Start(&Start{}))
+	kv.Commit()
+
+	m.checkInvariants()
+	return m
+}
+
+func (m *fuzzMatch) mustNotFail(err error) {
+	m.t.Helper()
+	if err != nil {
+		m.t.Fatalf("seed %d: %v", m.seed, err)
+	}
+}
+
+// Executes an action, keeping its changes only if it succeeds.
+func (m *fuzzMatch) execute(action arch.Action) {
+	if err := archmod.ActionSchemas.ExecuteAction(action, m.c); err != nil {
+		m.kv.Revert()
+	} else {
+		m.kv.Commit()
+	}
+}
+
+func (m *fuzzMatch) randomPlayerId() uint8 {
+	return uint8(m.rng.Intn(int(m.c.GetMeta().GetPlayerCount()))) + 1
+}
+
+func (m *fuzzMatch) randomPosition() image.Point {
+	size := m.c.BoardSize()
+	return image.Pt(m.rng.Intn(size.X), m.rng.Intn(size.Y))
+}
+
+func (m *fuzzMatch) randomUnitId(playerId uint8) uint8 {
+	nUnits := int(m.c.GetPlayer(playerId).GetUnitCount())
+	if nUnits == 0 {
+		return NilUnitId
+	}
+	return uint8(m.rng.Intn(nUnits)) + 1
+}
+
+func (m *fuzzMatch) randomBuildingId(playerId uint8) uint8 {
+	nBuildings := int(m.c.GetPlayer(playerId).GetBuildingCount())
+	if nBuildings == 0 {
+		return NilBuildingId
+	}
+	return uint8(m.rng.Intn(nBuildings)) + 1
+}
+
+func (m *fuzzMatch) randomWorkerCommand(playerId uint8) UnitCommandData {
+	command := NewWorkerCommandData(WorkerCommandType(m.rng.Intn(int(WorkerCommandType_Count))))
+	if m.rng.Intn(2) == 0 {
+		command.SetTargetBuilding(NilPlayerId, m.randomBuildingId(NilPlayerId))
+	} else {
+		command.SetTargetBuilding(playerId, m.randomBuildingId(playerId))
+	}
+	return command
+}
+
+func (m *fuzzMatch) randomFighterCommand(playerId uint8) UnitCommandData {
+	targetPlayerId := m.randomPlayerId()
+	command := NewFighterCommandData(FighterCommandType(m.rng.Intn(int(FighterCommandType_Count))))
+	switch {
+	case command.Type().IsTargetingBuilding():
+		command.SetTargetBuilding(targetPlayerId, m.randomBuildingId(targetPlayerId))
+	case command.Type().IsTargetingUnit():
+		command.SetTargetPlayerId(targetPlayerId)
+		command.SetTargetUnitId(m.randomUnitId(targetPlayerId))
+	default:
+		command.SetTargetPosition(m.randomPosition())
+	}
+	return command
+}
+
+func (m *fuzzMatch) randomAction() arch.Action {
+	playerId := m.randomPlayerId()
+	switch m.rng.Intn(10) {
+	case 0, 1, 2:
+		var (
+			spawnArea = m.c.GetSpawnArea(playerId)
+			x         = spawnArea.Min.X + m.rng.Intn(spawnArea.Dx())
+			y         = spawnArea.Min.Y + m.rng.Intn(spawnArea.Dy())
+			unitType  = uint8(m.rng.Intn(int(m.c.GetMeta().GetUnitPrototypeCount()))) + 1
+		)
+		return &UnitCreation{PlayerId: playerId, UnitType: unitType, X: uint16(x), Y: uint16(y)}
+	case 3:
+		if m.rng.Intn(8) == 0 {
+			return &BuildingDemolition{PlayerId: playerId, BuildingId: m.randomBuildingId(playerId)}
+		}
+		var (
+			position     = m.randomPosition()
+			buildingType = []uint8{fuzzProtoId_Armory, fuzzProtoId_Storage}[m.rng.Intn(2)]
+		)
+		return &BuildingPlacement{PlayerId: playerId, BuildingType: buildingType, X: uint16(position.X), Y: uint16(position.Y)}
+	case 4:
+		var (
+			unitIds = []uint8{m.randomUnitId(playerId), m.randomUnitId(playerId), m.randomUnitId(playerId)}
+			command = m.randomFighterCommand(playerId)
+		)
+		return NewUnitsAssignation(playerId, unitIds, command.Uint64(), 0, 0)
+	case 5:
+		if m.rng.Intn(20) == 0 {
+			if m.c.IsPaused() || m.c.GetPlayer(playerId).GetIsPauseRequested() {
+				return &Resumption{PlayerId: playerId}
+			}
+			return &PauseRequest{PlayerId: playerId}
+		}
+		if m.rng.Intn(4) == 0 {
+			return &UnitCancellation{PlayerId: playerId, UnitId: m.randomUnitId(playerId)}
+		}
+		fallthrough
+	default:
+		var (
+			unitId  = m.randomUnitId(playerId)
+			unit    = m.c.GetUnit(playerId, unitId)
+			command UnitCommandData
+		)
+		if m.c.GetUnitPrototype(unit.GetUnitType()).GetIsWorker() {
+			command = m.randomWorkerCommand(playerId)
+		} else {
+			command = m.randomFighterCommand(playerId)
+		}
+		path := &CommandPath{}
+		if m.rng.Intn(3) == 0 {
+			path.SetPath([]image.Point{m.randomPosition(), m.randomPosition()})
+		}
+		return &UnitAssignation{
+			PlayerId:     playerId,
+			UnitId:       unitId,
+			Command:      command.Uint64(),
+			CommandExtra: path.RawPath(),
+			CommandMeta:  path.Meta().Uint8(),
+		}
+	}
+}
+
+// Runs a block of random actions followed by its ticks, checking the invariants after every tick.
+func (m *fuzzMatch) runBlock() {
+	c := m.c
+	c.SetBlockNumber(c.BlockNumber() + 1)
+	for i := m.rng.Intn(4); i > 0; i-- {
+		m.execute(m.randomAction())
+	}
+	if c.BlockNumber()%32 == 0 {
+		c.Purge()
+		m.kv.Commit()
+		m.checkInvariants()
+	}
+	for i := uint64(0); i < c.TicksPerBlock(); i++ {
+		c.SetInBlockTickIndex(i)
+		// Keep the unit index past the end of the tick to check it was kept up to date
+		disableUnitIndex := c.enableUnitIndex()
+		arch.RunSingleTick(c)
+		m.kv.Commit()
+		m.checkUnitIndex()
+		disableUnitIndex()
+		m.checkInvariants()
+	}
+	c.SetInBlockTickIndex(0)
+}
+
+func (m *fuzzMatch) fail(format string, args ...interface{}) {
+	m.t.Helper()
+	m.t.Fatalf("seed %d, block %d: %s", m.seed, m.c.BlockNumber(), fmt.Sprintf(format, args...))
+}
+
+func (m *fuzzMatch) checkInvariants() {
+	m.t.Helper()
+	m.checkBoardInvariants()
+	m.c.ForEachPlayer(func(playerId uint8, player *datamod.PlayersRow) {
+		m.checkPlayerInvariants(playerId, player)
+	})
+}
+
+func (m *fuzzMatch) checkBoardInvariants() {
+	m.t.Helper()
+	var (
+		c    = m.c
+		size = c.BoardSize()
+	)
+	// Every unit reference on the board points to a live unit standing on that tile
+	for x := 0; x < size.X; x++ {
+		for y := 0; y < size.Y; y++ {
+			var (
+				position = image.Pt(x, y)
+				tile     = c.GetBoardTile(uint16(x), uint16(y))
+			)
+			if ObjectType(tile.GetLandObjectType()) == ObjectType_Building {
+				var (
+					playerId   = tile.GetLandPlayerId()
+					buildingId = tile.GetLandObjectId()
+				)
+				if buildingId == NilBuildingId || buildingId > c.GetPlayer(playerId).GetBuildingCount() {
+					m.fail("tile %v references nonexistent building %d:%d", position, playerId, buildingId)
+				}
+				if BuildingState(c.GetBuilding(playerId, buildingId).GetState()) == BuildingState_Destroyed {
+					m.fail("tile %v references destroyed building %d:%d", position, playerId, buildingId)
+				}
+				if !position.In(c.GetBuildingArea(playerId, buildingId)) {
+					m.fail("tile %v references building %d:%d outside of its area", position, playerId, buildingId)
+				}
+				building := c.GetBuilding(playerId, buildingId)
+				if BuildingState(building.GetState()) == BuildingState_Depleted && building.GetResourceReserve() != 0 {
+					m.fail("tile %v references depleted mine %d:%d with %d resources left", position, playerId, buildingId, building.GetResourceReserve())
+				}
+			}
+			refs := map[LayerId][2]uint8{
+				LayerId_Hover: {tile.GetHoverPlayerId(), tile.GetHoverUnitId()},
+				LayerId_Air:   {tile.GetAirPlayerId(), tile.GetAirUnitId()},
+			}
+			if ObjectType(tile.GetLandObjectType()) == ObjectType_Unit {
+				refs[LayerId_Land] = [2]uint8{tile.GetLandPlayerId(), tile.GetLandObjectId()}
+			}
+			for layer, ref := range refs {
+				playerId, unitId := ref[0], ref[1]
+				if unitId == NilUnitId {
+					continue
+				}
+				if terrain := GetTileTerrain(tile); !terrain.IsPassable(layer) {
+					m.fail("tile %v of %v terrain holds unit %d:%d in the %v layer", position, terrain, playerId, unitId, layer)
+				}
+				if playerId == NilPlayerId || playerId > c.GetMeta().GetPlayerCount() || unitId > c.GetPlayer(playerId).GetUnitCount() {
+					m.fail("tile %v references nonexistent unit %d:%d in the %v layer", position, playerId, unitId, layer)
+				}
+				unit := c.GetUnit(playerId, unitId)
+				if state := UnitState(unit.GetState()); state.IsDeadOrInactive() || state.IsNil() {
+					m.fail("tile %v references unit %d:%d in state %v", position, playerId, unitId, state)
+				}
+				if GetPositionAsPoint(unit) != position {
+					m.fail("tile %v references unit %d:%d at %v", position, playerId, unitId, GetPositionAsPoint(unit))
+				}
+				if unitLayer := LayerId(c.GetUnitPrototype(unit.GetUnitType()).GetLayer()); unitLayer != layer {
+					m.fail("tile %v references unit %d:%d of the %v layer in the %v layer", position, playerId, unitId, unitLayer, layer)
+				}
+			}
+		}
+	}
+}
+
+func (m *fuzzMatch) checkUnitIndex() {
+	m.t.Helper()
+	c := m.c
+
+	// The maintained index holds the same units as one built from scratch
+	count := func(index *unitIndex) map[indexedUnit]int {
+		counts := make(map[indexedUnit]int)
+		for _, bucket := range index.buckets {
+			for _, unit := range bucket {
+				counts[unit]++
+			}
+		}
+		return counts
+	}
+	maintained, built := count(c.unitIndex), count(c.buildUnitIndex())
+	for unit, n := range maintained {
+		if built[unit] != n {
+			m.fail("unit index holds %d entries of %+v, want %d", n, unit, built[unit])
+		}
+	}
+	for unit, n := range built {
+		if maintained[unit] != n {
+			m.fail("unit index holds %d entries of %+v, want %d", maintained[unit], unit, n)
+		}
+	}
+
+	// Range queries agree with a scan over every enemy unit
+	isActive := func(playerId, unitId uint8, unit *datamod.UnitsRow) bool {
+		return UnitState(unit.GetState()).IsActive()
+	}
+	c.ForEachPlayer(func(playerId uint8, _ *datamod.PlayersRow) {
+		c.ForEachUnit(playerId, func(unitId uint8, unit *datamod.UnitsRow) {
+			if !UnitState(unit.GetState()).IsActive() {
+				return
+			}
+			const _range = 3
+			position := GetPositionAsPoint(unit)
+			inRange := c.GetNearestEnemyUnitsInRange(playerId, position, _range, isActive)
+			for layer, match := range nearestEnemyUnitsByScan(c, playerId, position, isActive) {
+				if match.Distance > _range {
+					match = PlayerObjectMatchByDistance{}
+				}
+				if inRange[layer] != match {
+					m.fail("nearest enemy in the %v layer of unit %d:%d is %+v, want %+v", LayerId(layer), playerId, unitId, inRange[layer], match)
+				}
+			}
+		})
+	})
+}
+
+// Returns the nearest enemy unit on each layer by scanning every enemy unit, as a reference for
+// the unit index.
+func nearestEnemyUnitsByScan(c *Core, playerId uint8, position image.Point, filters ...UnitFilter) []PlayerObjectMatchByDistance {
+	matches := make([]PlayerObjectMatchByDistance, LayerId_Count)
+	nPlayers := c.GetMeta().GetPlayerCount()
+	for enemyPlayerId := uint8(1); enemyPlayerId < nPlayers+1; enemyPlayerId++ {
+		if c.AreAllies(playerId, enemyPlayerId) {
+			continue
+		}
+		iter := c.IterUnits(enemyPlayerId, filters...)
+		for iter.Next() {
+			var (
+				unitId, _unit = iter.Value()
+				unit          = _unit.(*datamod.UnitsRow)
+				unitPosition  = GetPositionAsPoint(unit)
+				protoId       = unit.GetUnitType()
+				proto         = c.GetUnitPrototype(protoId)
+				layer         = proto.GetLayer()
+				distance      = Distance(position, unitPosition)
+			)
+			if matches[layer].IsNil() || distance < matches[layer].Distance {
+				matches[layer] = PlayerObjectMatchByDistance{
+					PlayerId: enemyPlayerId,
+					ObjectMatchByDistance: ObjectMatchByDistance{
+						ObjectId: unitId,
+						Distance: distance,
+					},
+				}
+			}
+		}
+	}
+	return matches
+}
+
+func (m *fuzzMatch) checkPlayerInvariants(playerId uint8, player *datamod.PlayersRow) {
+	m.t.Helper()
+	c := m.c
+
+	if player.GetCurResource() > player.GetMaxResource() {
+		m.fail("player %d has %d resources over a capacity of %d", playerId, player.GetCurResource(), player.GetMaxResource())
+	}
+
+	// Every unit on the board occupies its tile, and compute demand adds up the paid live units
+	var (
+		payPointer    = player.GetUnitPayQueuePointer()
+		tail          = c.nextUnitId(playerId, player.GetLastUnitId())
+		inPayQueue    = make(map[uint8]bool)
+		computeDemand = 0
+	)
+	for unitId := payPointer; unitId != tail; unitId = c.nextUnitId(playerId, unitId) {
+		inPayQueue[unitId] = true
+	}
+	c.ForEachUnit(playerId, func(unitId uint8, unit *datamod.UnitsRow) {
+		var (
+			state = UnitState(unit.GetState())
+			proto = c.GetUnitPrototype(unit.GetUnitType())
+			layer = LayerId(proto.GetLayer())
+			tile  = c.GetBoardTile(unit.GetX(), unit.GetY())
+		)
+		if !inPayQueue[unitId] && state == UnitState_Unpaid {
+			m.fail("unit %d:%d is unpaid but not in the pay queue", playerId, unitId)
+		}
+		if state == UnitState_Spawning || state == UnitState_Active || state == UnitState_Inactive {
+			computeDemand += int(proto.GetComputeCost())
+		}
+		if state.IsDeadOrInactive() || state.IsNil() {
+			return
+		}
+		var tilePlayerId, tileUnitId uint8
+		switch layer {
+		case LayerId_Land:
+			tilePlayerId, tileUnitId = tile.GetLandPlayerId(), tile.GetLandObjectId()
+			if ObjectType(tile.GetLandObjectType()) != ObjectType_Unit {
+				tileUnitId = NilUnitId
+			}
+		case LayerId_Hover:
+			tilePlayerId, tileUnitId = tile.GetHoverPlayerId(), tile.GetHoverUnitId()
+		case LayerId_Air:
+			tilePlayerId, tileUnitId = tile.GetAirPlayerId(), tile.GetAirUnitId()
+		}
+		if tilePlayerId != playerId || tileUnitId != unitId {
+			m.fail("unit %d:%d in state %v is not on its tile %v", playerId, unitId, state, GetPositionAsPoint(unit))
+		}
+	})
+	if int(player.GetComputeDemand()) != computeDemand {
+		m.fail("player %d has a compute demand of %d, expected %d", playerId, player.GetComputeDemand(), computeDemand)
+	}
+
+	// Pay queue pointers only move forward
+	var (
+		buildingPayPointer   = player.GetBuildingPayQueuePointer()
+		buildingBuildPointer = player.GetBuildingBuildQueuePointer()
+	)
+	if buildingPayPointer < m.buildingPayPointers[playerId] {
+		m.fail("player %d building pay pointer moved back from %d to %d", playerId, m.buildingPayPointers[playerId], buildingPayPointer)
+	}
+	if buildingBuildPointer < m.buildingBuildPointers[playerId] {
+		m.fail("player %d building build pointer moved back from %d to %d", playerId, m.buildingBuildPointers[playerId], buildingBuildPointer)
+	}
+	if buildingBuildPointer > buildingPayPointer || int(buildingPayPointer) > int(player.GetBuildingCount())+1 {
+		m.fail("player %d building pointers %d and %d out of order for %d buildings", playerId, buildingBuildPointer, buildingPayPointer, player.GetBuildingCount())
+	}
+	if prevPayPointer, ok := m.unitPayPointers[playerId]; ok && prevPayPointer != payPointer {
+		// The pointer moves along the unit id ring but never past the end of the queue
+		moved := false
+		for unitId := prevPayPointer; unitId != tail; unitId = c.nextUnitId(playerId, unitId) {
+			if unitId == payPointer {
+				moved = true
+				break
+			}
+		}
+		if !moved && payPointer != tail {
+			m.fail("player %d unit pay pointer moved back from %d to %d", playerId, prevPayPointer, payPointer)
+		}
+	}
+	m.buildingPayPointers[playerId] = buildingPayPointer
+	m.buildingBuildPointers[playerId] = buildingBuildPointer
+	m.unitPayPointers[playerId] = payPointer
+}
+
+func TestCoreInvariants(t *testing.T) {
+	nSeeds, nBlocks := 2, 1000
+	if testing.Short() {
+		nSeeds, nBlocks = 1, 200
+	}
+	for seed := int64(1); seed <= int64(nSeeds); seed++ {
+		m := newFuzzMatch(t, seed)
+		for i := 0; i < nBlocks && !m.c.IsGameOver(); i++ {
+			m.runBlock()
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package rts
+
+import (
+	"container/heap"
+	"image"
+)
+
+// Default maximum number of nodes expanded by a single path search. It covers the whole 15x8
+// royale board (120 tiles) with room to spare.
+const DefaultPathFindNodeBudget = 160
+
+// Steps in the order neighbours are explored. Orthogonal steps are prioritized.
+// The order is part of the tie-break and must not change or the core will diverge.
+var pathFindSteps = [8]image.Point{
+	{0, -1}, {1, 0}, {0, 1}, {-1, 0},
+	{1, -1}, {1, 1}, {-1, 1}, {-1, -1},
+}
+
+type pathFindNode struct {
+	position  image.Point
+	parent    int
+	g         int // Steps from the start
+	h         int // Chebyshev distance to the target area
+	manhattan int // Manhattan distance to the target area, used as tie-break
+}
+
+func (n *pathFindNode) f() int {
+	return n.g + n.h
+}
+
+// pathFindQueue is a min-heap of node indices ordered by f, h, manhattan distance and
+// insertion order, in that order. Node indices are assigned in insertion order.
+type pathFindQueue struct {
+	nodes   *[]pathFindNode
+	indices []int
+}
+
+func (q *pathFindQueue) Len() int {
+	return len(q.indices)
+}
+
+func (q *pathFindQueue) Less(i, j int) bool {
+	a, b := &(*q.nodes)[q.indices[i]], &(*q.nodes)[q.indices[j]]
+	if a.f() != b.f() {
+		return a.f() < b.f()
+	}
+	if a.h != b.h {
+		return a.h < b.h
+	}
+	if a.manhattan != b.manhattan {
+		return a.manhattan < b.manhattan
+	}
+	return q.indices[i] < q.indices[j]
+}
+
+func (q *pathFindQueue) Swap(i, j int) {
+	q.indices[i], q.indices[j] = q.indices[j], q.indices[i]
+}
+
+func (q *pathFindQueue) Push(x interface{}) {
+	q.indices = append(q.indices, x.(int))
+}
+
+func (q *pathFindQueue) Pop() interface{} {
+	n := len(q.indices)
+	idx := q.indices[n-1]
+	q.indices = q.indices[:n-1]
+	return idx
+}
+
+// closerToTarget returns true if a is a better fallback destination than b.
+func closerToTarget(a, b *pathFindNode) bool {
+	if a.h != b.h {
+		return a.h < b.h
+	}
+	if a.g != b.g {
+		return a.g < b.g
+	}
+	return a.manhattan < b.manhattan
+}
+
+// pathFind runs a bounded A* search from position to the nearest tile in targetArea the
+// unit can move to and returns the first step of the path.
+// If the target is unreachable or the node budget is exhausted, it returns the first step
+// towards the explored tile closest to the target area.
+// If no step improves on the current position, it returns position.
+func (c *Core) pathFind(layer LayerId, position image.Point, targetArea image.Rectangle) image.Point {
+	return c.pathFindWithBudget(layer, position, targetArea, c.PathFindNodeBudget())
+}
+
+// Returns the maximum number of nodes expanded by a single path search as set at initialization.
+// The budget changes the paths found, so it is part of the match state that every core shares.
+func (c *Core) PathFindNodeBudget() int {
+	if budget := c.GetMeta().GetPathFindNodeBudget(); budget != 0 {
+		return int(budget)
+	}
+	return DefaultPathFindNodeBudget
+}
+
+func (c *Core) pathFindWithBudget(layer LayerId, position image.Point, targetArea image.Rectangle, budget int) image.Point {
+	nodes := []pathFindNode{{
+		position:  position,
+		parent:    -1,
+		g:         0,
+		h:         DistanceToArea(position, targetArea),
+		manhattan: ManhattanDistanceToArea(position, targetArea),
+	}}
+	if nodes[0].h == 0 {
+		return position
+	}
+
+	var (
+		queue    = &pathFindQueue{nodes: &nodes, indices: []int{0}}
+		bestG    = map[image.Point]int{position: 0}
+		closed   = map[image.Point]bool{}
+		bestIdx  = 0
+		goalIdx  = -1
+		expanded = 0
+	)
+
+	for queue.Len() > 0 && expanded < budget {
+		idx := heap.Pop(queue).(int)
+		node := nodes[idx]
+		if closed[node.position] {
+			continue
+		}
+		closed[node.position] = true
+		expanded++
+
+		if node.h == 0 {
+			goalIdx = idx
+			break
+		}
+		if closerToTarget(&node, &nodes[bestIdx]) {
+			bestIdx = idx
+		}
+
+		for _, step := range pathFindSteps {
+			next := node.position.Add(step)
+			if closed[next] {
+				continue
+			}
+			g := node.g + 1
+			if prevG, ok := bestG[next]; ok && prevG <= g {
+				continue
+			}
+			if !c.unitCanMoveTo(next, layer) {
+				continue
+			}
+			bestG[next] = g
+			nodes = append(nodes, pathFindNode{
+				position:  next,
+				parent:    idx,
+				g:         g,
+				h:         DistanceToArea(next, targetArea),
+				manhattan: ManhattanDistanceToArea(next, targetArea),
+			})
+			heap.Push(queue, len(nodes)-1)
+		}
+	}
+
+	destIdx := goalIdx
+	if destIdx == -1 {
+		destIdx = bestIdx
+	}
+	if destIdx == 0 {
+		return position
+	}
+	for nodes[destIdx].parent != 0 {
+		destIdx = nodes[destIdx].parent
+	}
+	return nodes[destIdx].position
+}
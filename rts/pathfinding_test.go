@@ -0,0 +1,158 @@
+package rts
+
+import (
+	"image"
+	"testing"
+
+	"github.com/concrete-eth/archetype/kvstore"
+)
+
+func newTestCore(t *testing.T, width, height uint16) *Core {
+	c := &Core{}
+	c.SetKV(kvstore.NewMemoryKeyValueStore())
+	if err := c.Initialize(&Initialization{Width: width, Height: height}); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestPathFindAroundWall(t *testing.T) {
+	c := newTestCore(t, 8, 4)
+
+	// Wall at x = 3 with a single opening at y = 3
+	for y := uint16(0); y < 3; y++ {
+		SetTileLandObject(c.GetBoardTile(3, y), ObjectType_Building, 0, 1)
+	}
+
+	position := image.Point{0, 0}
+	targetArea := image.Rectangle{Min: image.Point{6, 0}, Max: image.Point{7, 1}}
+
+	steps := 0
+	for !position.In(targetArea) {
+		next := c.pathFind(LayerId_Land, position, targetArea)
+		if next.Eq(position) {
+			t.Fatalf("stuck at %v after %d steps", position, steps)
+		}
+		if ChebyshevDistance(position, next) != 1 {
+			t.Fatalf("expected a single step from %v, got %v", position, next)
+		}
+		position = next
+		steps++
+		if steps > 16 {
+			t.Fatalf("path too long")
+		}
+	}
+	if steps != 6 {
+		t.Errorf("expected 6 steps, got %d", steps)
+	}
+}
+
+func TestPathFindDeterministic(t *testing.T) {
+	c := newTestCore(t, 8, 8)
+	position := image.Point{0, 0}
+	targetArea := image.Rectangle{Min: image.Point{5, 5}, Max: image.Point{6, 6}}
+
+	first := c.pathFind(LayerId_Land, position, targetArea)
+	for i := 0; i < 8; i++ {
+		if next := c.pathFind(LayerId_Land, position, targetArea); !next.Eq(first) {
+			t.Fatalf("expected %v, got %v", first, next)
+		}
+	}
+	if !first.Eq(image.Point{1, 1}) {
+		t.Errorf("expected diagonal step %v, got %v", image.Point{1, 1}, first)
+	}
+}
+
+func TestPathFindBudgetExhausted(t *testing.T) {
+	c := newTestCore(t, 8, 1)
+
+	position := image.Point{0, 0}
+	targetArea := image.Rectangle{Min: image.Point{7, 0}, Max: image.Point{8, 1}}
+
+	// With a single node the search cannot look past the start tile
+	if next := c.pathFindWithBudget(LayerId_Land, position, targetArea, 1); !next.Eq(position) {
+		t.Errorf("expected to stay at %v, got %v", position, next)
+	}
+
+	if next := c.pathFindWithBudget(LayerId_Land, position, targetArea, 2); !next.Eq(image.Point{1, 0}) {
+		t.Errorf("expected %v, got %v", image.Point{1, 0}, next)
+	}
+}
+
+func TestPathFindNodeBudget(t *testing.T) {
+	c := newTestCore(t, 8, 1)
+	if budget := c.PathFindNodeBudget(); budget != DefaultPathFindNodeBudget {
+		t.Errorf("expected default budget %d, got %d", DefaultPathFindNodeBudget, budget)
+	}
+
+	c = &Core{}
+	c.SetKV(kvstore.NewMemoryKeyValueStore())
+	mustNotFail(t, c.Initialize(&Initialization{Width: 8, Height: 1, PathFindNodeBudget: 1}))
+	if budget := c.PathFindNodeBudget(); budget != 1 {
+		t.Fatalf("expected budget %d, got %d", 1, budget)
+	}
+	position := image.Point{0, 0}
+	targetArea := image.Rectangle{Min: image.Point{7, 0}, Max: image.Point{8, 1}}
+	if next := c.pathFind(LayerId_Land, position, targetArea); !next.Eq(position) {
+		t.Errorf("expected to stay at %v with the configured budget, got %v", position, next)
+	}
+}
+
+func TestPathFindTerrain(t *testing.T) {
+	tests := []struct {
+		terrain   TerrainType
+		reachable map[LayerId]bool
+	}{
+		{TerrainType_Water, map[LayerId]bool{LayerId_Land: false, LayerId_Hover: true, LayerId_Air: true}},
+		{TerrainType_Cliff, map[LayerId]bool{LayerId_Land: false, LayerId_Hover: false, LayerId_Air: true}},
+		{TerrainType_Rough, map[LayerId]bool{LayerId_Land: true, LayerId_Hover: false, LayerId_Air: true}},
+	}
+	for _, tt := range tests {
+		c := newTestCore(t, 8, 4)
+
+		// Terrain across the whole board at x = 3
+		for y := uint16(0); y < 4; y++ {
+			if err := c.SetTerrain(&TerrainSetting{X: 3, Y: y, Terrain: tt.terrain.Uint8()}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		targetArea := image.Rectangle{Min: image.Point{6, 0}, Max: image.Point{7, 1}}
+		for layer, reachable := range tt.reachable {
+			position := image.Point{0, 0}
+			for steps := 0; !position.In(targetArea) && steps < 16; steps++ {
+				next := c.pathFind(layer, position, targetArea)
+				if next.Eq(position) {
+					break
+				}
+				if terrain := GetTileTerrain(c.GetBoardTile(uint16(next.X), uint16(next.Y))); !terrain.IsPassable(layer) {
+					t.Fatalf("%v unit stepped on %v terrain at %v", layer, terrain, next)
+				}
+				position = next
+			}
+			if position.In(targetArea) != reachable {
+				t.Errorf("expected target reachable across %v terrain by %v unit to be %v", tt.terrain, layer, reachable)
+			}
+		}
+	}
+}
+
+func TestSetTerrain(t *testing.T) {
+	c := newTestCore(t, 4, 4)
+	if err := c.SetTerrain(&TerrainSetting{X: 4, Y: 0, Terrain: TerrainType_Water.Uint8()}); err != ErrInvalidPosition {
+		t.Errorf("expected %v, got %v", ErrInvalidPosition, err)
+	}
+	if err := c.SetTerrain(&TerrainSetting{X: 0, Y: 0, Terrain: TerrainType_Count.Uint8()}); err != ErrInvalidTerrainType {
+		t.Errorf("expected %v, got %v", ErrInvalidTerrainType, err)
+	}
+	SetTileLandObject(c.GetBoardTile(1, 0), ObjectType_Building, 0, 1)
+	if err := c.SetTerrain(&TerrainSetting{X: 1, Y: 0, Terrain: TerrainType_Water.Uint8()}); err != ErrTileOccupied {
+		t.Errorf("expected %v, got %v", ErrTileOccupied, err)
+	}
+	if err := c.SetTerrain(&TerrainSetting{X: 2, Y: 0, Terrain: TerrainType_Rough.Uint8()}); err != nil {
+		t.Fatal(err)
+	}
+	if c.IsBuildableArea(image.Rect(2, 0, 3, 1)) {
+		t.Errorf("expected rough terrain not to be buildable")
+	}
+}
@@ -14,8 +14,8 @@ func addStorage(player *datamod.PlayersRow, resource uint16) {
 
 func subStorage(player *datamod.PlayersRow, resource uint16) {
 	if resource > 0 {
-		maxResource := player.GetMaxResource()
-		player.SetMaxResource(utils.SafeSubUint16(maxResource, resource))
+		maxResource := utils.SafeSubUint16(player.GetMaxResource(), resource)
+		player.SetMaxResource(maxResource)
 		if player.GetCurResource() > maxResource {
 			player.SetCurResource(maxResource)
 		}
@@ -0,0 +1,257 @@
+package rts
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/concrete-eth/archetype/arch"
+	"github.com/concrete-eth/archetype/kvstore"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+/*
+Replay file format (gzip compressed):
+
+	header: magic [4]byte "ARKR", version uint8, defaultTicksPerBlock uvarint
+	batch:  blockNumber uvarint, actionCount uvarint, action...
+	action: recordedActionType uint8
+	        [recordedActionType == Action] actionId [4]byte, dataLen uvarint, data [dataLen]byte
+
+Action data is ABI encoded the same way as the ActionExecuted log data. Each batch is one block
+and each tick action is one block worth of ticks. The ticks per block of the match are set by the
+recorded Initialize action, the header only holds the value the recording client assumed before it.
+*/
+
+const replayFormatVersion = 1
+
+var replayMagic = [4]byte{'A', 'R', 'K', 'R'}
+
+type recordedActionType uint8
+
+const (
+	recordedActionType_Tick recordedActionType = iota
+	recordedActionType_Purge
+	recordedActionType_Action
+)
+
+var (
+	ErrInvalidReplayHeader      = errors.New("invalid replay header")
+	ErrUnsupportedReplayVersion = errors.New("unsupported replay version")
+	ErrTicksPerBlockMismatch    = errors.New("ticks per block mismatch")
+	ErrRecorderClosed           = errors.New("recorder closed")
+)
+
+// ActionRecorder writes action batches to a replay file.
+type ActionRecorder struct {
+	lock   sync.Mutex
+	gz     *gzip.Writer
+	buf    []byte
+	closed bool
+}
+
+// NewActionRecorder creates a recorder that writes to w and writes the replay header.
+func NewActionRecorder(w io.Writer, ticksPerBlock uint64) (*ActionRecorder, error) {
+	r := &ActionRecorder{gz: gzip.NewWriter(w)}
+	r.buf = append(r.buf, replayMagic[:]...)
+	r.buf = append(r.buf, replayFormatVersion)
+	r.buf = binary.AppendUvarint(r.buf, ticksPerBlock)
+	if err := r.flush(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *ActionRecorder) flush() error {
+	if _, err := r.gz.Write(r.buf); err != nil {
+		return err
+	}
+	r.buf = r.buf[:0]
+	// Flush every batch so the replay is usable even if the recorder is never closed
+	return r.gz.Flush()
+}
+
+// RecordBatch appends an action batch to the replay.
+func (r *ActionRecorder) RecordBatch(batch arch.ActionBatch) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return ErrRecorderClosed
+	}
+	r.buf = binary.AppendUvarint(r.buf, batch.BlockNumber)
+	r.buf = binary.AppendUvarint(r.buf, uint64(len(batch.Actions)))
+	for _, action := range batch.Actions {
+		switch action.(type) {
+		case *arch.CanonicalTickAction:
+			r.buf = append(r.buf, uint8(recordedActionType_Tick))
+		case *arch.CanonicalPurgeAction:
+			r.buf = append(r.buf, uint8(recordedActionType_Purge))
+		default:
+			actionId, data, err := archmod.ActionSchemas.EncodeAction(action)
+			if err != nil {
+				r.buf = r.buf[:0]
+				return err
+			}
+			rawId := actionId.Raw()
+			r.buf = append(r.buf, uint8(recordedActionType_Action))
+			r.buf = append(r.buf, rawId[:]...)
+			r.buf = binary.AppendUvarint(r.buf, uint64(len(data)))
+			r.buf = append(r.buf, data...)
+		}
+	}
+	return r.flush()
+}
+
+// Close flushes and closes the replay. It does not close the underlying writer.
+func (r *ActionRecorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	return r.gz.Close()
+}
+
+// ActionBatchReader reads action batches from a replay file.
+type ActionBatchReader struct {
+	r             *bufio.Reader
+	ticksPerBlock uint64
+}
+
+// NewActionBatchReader creates a reader from r and reads the replay header.
+func NewActionBatchReader(r io.Reader) (*ActionBatchReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(gz)
+	var magic [4]byte
+	if _, err := io.ReadFull(br, magic[:]); err != nil || magic != replayMagic {
+		return nil, ErrInvalidReplayHeader
+	}
+	version, err := br.ReadByte()
+	if err != nil {
+		return nil, ErrInvalidReplayHeader
+	}
+	if version != replayFormatVersion {
+		return nil, ErrUnsupportedReplayVersion
+	}
+	ticksPerBlock, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, ErrInvalidReplayHeader
+	}
+	return &ActionBatchReader{r: br, ticksPerBlock: ticksPerBlock}, nil
+}
+
+func (r *ActionBatchReader) TicksPerBlock() uint64 {
+	return r.ticksPerBlock
+}
+
+// Next returns the next action batch in the replay or io.EOF if there are none left.
+func (r *ActionBatchReader) Next() (arch.ActionBatch, error) {
+	blockNumber, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			// The recorder was not closed, everything up to the last flush is valid
+			return arch.ActionBatch{}, io.EOF
+		}
+		return arch.ActionBatch{}, err
+	}
+	actionCount, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return arch.ActionBatch{}, unexpectedEOF(err)
+	}
+	actions := make([]arch.Action, 0, actionCount)
+	for i := uint64(0); i < actionCount; i++ {
+		action, err := r.readAction()
+		if err != nil {
+			return arch.ActionBatch{}, unexpectedEOF(err)
+		}
+		actions = append(actions, action)
+	}
+	return arch.NewActionBatch(blockNumber, actions), nil
+}
+
+func (r *ActionBatchReader) readAction() (arch.Action, error) {
+	actionType, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch recordedActionType(actionType) {
+	case recordedActionType_Tick:
+		return &arch.CanonicalTickAction{}, nil
+	case recordedActionType_Purge:
+		return &arch.CanonicalPurgeAction{}, nil
+	case recordedActionType_Action:
+		var rawId arch.RawIdType
+		if _, err := io.ReadFull(r.r, rawId[:]); err != nil {
+			return nil, err
+		}
+		actionId, ok := archmod.ActionSchemas.NewActionId(rawId)
+		if !ok {
+			return nil, fmt.Errorf("invalid action id: %x", rawId)
+		}
+		dataLen, err := binary.ReadUvarint(r.r)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, dataLen)
+		if _, err := io.ReadFull(r.r, data); err != nil {
+			return nil, err
+		}
+		return archmod.ActionSchemas.DecodeAction(actionId, data)
+	default:
+		return nil, fmt.Errorf("invalid recorded action type: %d", actionType)
+	}
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// Replay applies every action batch in the replay to the core in the same way the client applies
+// batches received from the chain.
+func Replay(r io.Reader, core *Core) error {
+	reader, err := NewActionBatchReader(r)
+	if err != nil {
+		return err
+	}
+	if core.IsInitialized() && reader.TicksPerBlock() != core.TicksPerBlock() {
+		return ErrTicksPerBlockMismatch
+	}
+	core.SetDefaultTicksPerBlock(reader.TicksPerBlock())
+	for {
+		batch, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		core.SetBlockNumber(batch.BlockNumber)
+		for _, action := range batch.Actions {
+			if err := archmod.ActionSchemas.ExecuteAction(action, core); err != nil {
+				log.Error("failed to execute action", "err", err)
+			}
+		}
+		core.SetBlockNumber(batch.BlockNumber + 1)
+	}
+}
+
+// NewCoreFromReplay rebuilds the game state recorded in a replay on a fresh in-memory store.
+func NewCoreFromReplay(r io.Reader) (*Core, error) {
+	core := &Core{}
+	core.SetKV(kvstore.NewMemoryKeyValueStore())
+	if err := Replay(r, core); err != nil {
+		return nil, err
+	}
+	return core, nil
+}
@@ -0,0 +1,129 @@
+package rts
+
+import (
+	"bytes"
+	"image"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/concrete-eth/archetype/arch"
+	arch_client "github.com/concrete-eth/archetype/client"
+	"github.com/concrete-eth/archetype/kvstore"
+	"github.com/concrete-eth/ark-royale/gogen/archmod"
+)
+
+func TestActionRecorderRoundTrip(t *testing.T) {
+	batches := []arch.ActionBatch{
+		arch.NewActionBatch(10, []arch.Action{
+			&Initialization{Width: 8, Height: 4},
+			&UnitPrototypeAddition{Layer: 1, ResourceCost: 300, MaxIntegrity: 25, IsWorker: true},
+		}),
+		arch.NewActionBatch(11, []arch.Action{}),
+		arch.NewActionBatch(12, []arch.Action{
+			&arch.CanonicalTickAction{},
+			&UnitAssignation{PlayerId: 1, UnitId: 2, Command: 1 << 40, CommandExtra: 0xFFFF, CommandMeta: 0x21},
+			NewUnitsAssignation(2, []uint8{1, 9, 255}, 1<<40, 0, 0),
+			&arch.CanonicalPurgeAction{},
+		}),
+	}
+
+	var buf bytes.Buffer
+	recorder, err := NewActionRecorder(&buf, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, batch := range batches {
+		if err := recorder.RecordBatch(batch); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Do not close the recorder, flushed batches must be readable anyway
+
+	reader, err := NewActionBatchReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reader.TicksPerBlock() != 1 {
+		t.Errorf("expected ticks per block %v, got %v", 1, reader.TicksPerBlock())
+	}
+	for i, expected := range batches {
+		batch, err := reader.Next()
+		if err != nil {
+			t.Fatalf("batch %d: %v", i, err)
+		}
+		if batch.BlockNumber != expected.BlockNumber {
+			t.Errorf("batch %d: expected block number %v, got %v", i, expected.BlockNumber, batch.BlockNumber)
+		}
+		if !reflect.DeepEqual(batch.Actions, expected.Actions) {
+			t.Errorf("batch %d: expected actions %v, got %v", i, expected.Actions, batch.Actions)
+		}
+	}
+	if _, err := reader.Next(); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+}
+
+func TestReplayMatchesLiveCore(t *testing.T) {
+	command := NewFighterCommandData(FighterCommandType_AttackMove)
+	command.SetTargetPosition(image.Point{9, 1})
+	batches := []arch.ActionBatch{
+		arch.NewActionBatch(1, []arch.Action{
+			&Initialization{Width: 12, Height: 3},
+			&BuildingPrototypeAddition{Width: 1, Height: 1, MaxIntegrity: 100, ResourceCapacity: 1000, ComputeCapacity: 16, VisionRadius: 2},
+			&UnitPrototypeAddition{Layer: uint8(LayerId_Land), SpawnTime: 1, MaxIntegrity: 20, LandStrength: 5, AttackRange: 1, AttackCooldown: 1, VisionRadius: 2},
+			&UnitPrototypeAddition{Layer: uint8(LayerId_Land), SpawnTime: 1, MaxIntegrity: 10, VisionRadius: 2},
+			&PlayerAddition{SpawnAreaX: 1, SpawnAreaWidth: 3, SpawnAreaHeight: 3},
+			&PlayerAddition{SpawnAreaX: 4, SpawnAreaWidth: 7, SpawnAreaHeight: 3},
+			&BuildingPlacement{PlayerId: 1, BuildingType: 1, X: 0, Y: 0},
+			&BuildingPlacement{PlayerId: 2, BuildingType: 1, X: 11, Y: 0},
+		}),
+		arch.NewActionBatch(2, []arch.Action{&Start{}}),
+	}
+	for blockNumber := uint64(3); blockNumber < 40; blockNumber++ {
+		actions := []arch.Action{&arch.CanonicalTickAction{}}
+		switch blockNumber {
+		case 4:
+			actions = append(actions,
+				&UnitCreation{PlayerId: 1, UnitType: 1, X: 1, Y: 1},
+				&UnitCreation{PlayerId: 2, UnitType: 2, X: 6, Y: 1},
+			)
+		case 8:
+			actions = append(actions, &UnitAssignation{PlayerId: 1, UnitId: 1, Command: command.Uint64()})
+		}
+		actions = append(actions, &arch.CanonicalPurgeAction{})
+		batches = append(batches, arch.NewActionBatch(blockNumber, actions))
+	}
+
+	// Sync a live core from the batches as a client would while recording them
+	var buf bytes.Buffer
+	recorder, err := NewActionRecorder(&buf, DefaultTicksPerBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batchChan := make(chan arch.ActionBatch, len(batches))
+	for _, batch := range batches {
+		mustNotFail(t, recorder.RecordBatch(batch))
+		batchChan <- batch
+	}
+	mustNotFail(t, recorder.Close())
+	live := &Core{}
+	schemas := arch.ArchSchemas{Actions: archmod.ActionSchemas, Tables: archmod.TableSchemas}
+	cli := arch_client.New(schemas, live, kvstore.NewMemoryKeyValueStore(), batchChan, nil, time.Second, 1)
+	mustNotFail(t, cli.SyncUntil(40))
+	if state := UnitState(live.GetUnit(2, 1).GetState()); state != UnitState_Dead {
+		t.Fatalf("expected the live match to play out, got enemy unit state %v", state)
+	}
+
+	replayed, err := NewCoreFromReplay(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.BlockNumber() != live.BlockNumber() {
+		t.Errorf("expected block number %d, got %d", live.BlockNumber(), replayed.BlockNumber())
+	}
+	if hash, liveHash := replayed.StateHash(), live.StateHash(); hash != liveHash {
+		t.Errorf("expected replayed state hash %v, got %v", liveHash, hash)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"slices"
 
 	"github.com/concrete-eth/archetype/arch"
 	"github.com/concrete-eth/archetype/utils"
@@ -17,8 +18,17 @@ const (
 	NilPlayerId   = NilObjectId
 	NilBuildingId = NilObjectId
 	NilUnitId     = NilObjectId
+	NilTeamId     = uint8(0)
 )
 
+// Id of the main building, the first building placed by each player.
+const MainBuildingId = uint8(1)
+
+const DefaultTicksPerBlock = 1
+
+// Maximum number of ticks a single pause can last before the match resumes on its own.
+const MaxPauseTicks = 300
+
 type ObjectType uint8
 
 const (
@@ -125,31 +135,192 @@ const (
 	InternalEventId_Killed
 	InternalEventId_Built
 	InternalEventId_Destroyed
+	InternalEventId_Recycled
+	InternalEventId_ResourceDeposited
+	InternalEventId_MineHarvested
+	InternalEventId_CommandChanged
+	InternalEventId_BuildingPlaced
+	InternalEventId_UnitPaid
+	InternalEventId_PlayerEliminated
+	InternalEventId_MineDepleted
+	InternalEventId_Cancelled
+	InternalEventId_Demolished
 )
 
-type InternalEventHandler func(eventId uint8, data interface{})
+// Implemented by every event emitted by the core.
+type InternalEvent interface {
+	EventId() uint8
+	TickIndex() uint32
+	setTickIndex(tickIndex uint32)
+}
+
+// Embedded in every internal event.
+type InternalEventBase struct {
+	Tick uint32 // AbsSubTickIndex at the time the event was emitted
+}
+
+func (e *InternalEventBase) TickIndex() uint32 {
+	return e.Tick
+}
+
+func (e *InternalEventBase) setTickIndex(tickIndex uint32) {
+	e.Tick = tickIndex
+}
+
+type InternalEventHandler func(event InternalEvent)
 
 type InternalEvent_Shot struct {
+	InternalEventBase
 	Attacker Object
 	Target   Object
+	IsSplash bool // Target was hit by the splash of a shot aimed at another unit
 }
 
 type InternalEvent_Spawned struct {
+	InternalEventBase
 	Unit Object
 }
 
 type InternalEvent_Killed struct {
+	InternalEventBase
 	Unit Object
 }
 
 type InternalEvent_Built struct {
+	InternalEventBase
 	Building Object
 }
 
 type InternalEvent_Destroyed struct {
+	InternalEventBase
 	Building Object
 }
 
+// Emitted when the id of a dead unit is assigned to a newly created unit.
+type InternalEvent_Recycled struct {
+	InternalEventBase
+	Unit Object
+}
+
+// Emitted when a worker unloads resources at its main building. Amount is the resource added to the
+// player after any compute penalty.
+type InternalEvent_ResourceDeposited struct {
+	InternalEventBase
+	Unit   Object
+	Amount uint16
+}
+
+// Emitted when a worker loads resources from a mine.
+type InternalEvent_MineHarvested struct {
+	InternalEventBase
+	Unit   Object
+	Mine   Object
+	Amount uint8
+}
+
+// Emitted when a unit is given a command.
+type InternalEvent_CommandChanged struct {
+	InternalEventBase
+	Unit    Object
+	Command uint64
+}
+
+type InternalEvent_BuildingPlaced struct {
+	InternalEventBase
+	Building Object
+}
+
+// Emitted when a unit is paid for and starts spawning.
+type InternalEvent_UnitPaid struct {
+	InternalEventBase
+	Unit Object
+}
+
+type InternalEvent_PlayerEliminated struct {
+	InternalEventBase
+	PlayerId uint8
+}
+
+// Emitted when the last resources of a mine with a limited reserve are loaded.
+type InternalEvent_MineDepleted struct {
+	InternalEventBase
+	Mine Object
+}
+
+// Emitted when an unpaid or spawning unit is cancelled by its player.
+type InternalEvent_Cancelled struct {
+	InternalEventBase
+	Unit Object
+}
+
+// Emitted when a player demolishes one of their buildings. Refund is the resource returned to the
+// player.
+type InternalEvent_Demolished struct {
+	InternalEventBase
+	Building Object
+	Refund   uint16
+}
+
+func (*InternalEvent_Shot) EventId() uint8 {
+	return InternalEventId_Shot
+}
+
+func (*InternalEvent_Spawned) EventId() uint8 {
+	return InternalEventId_Spawned
+}
+
+func (*InternalEvent_Killed) EventId() uint8 {
+	return InternalEventId_Killed
+}
+
+func (*InternalEvent_Built) EventId() uint8 {
+	return InternalEventId_Built
+}
+
+func (*InternalEvent_Destroyed) EventId() uint8 {
+	return InternalEventId_Destroyed
+}
+
+func (*InternalEvent_Recycled) EventId() uint8 {
+	return InternalEventId_Recycled
+}
+
+func (*InternalEvent_ResourceDeposited) EventId() uint8 {
+	return InternalEventId_ResourceDeposited
+}
+
+func (*InternalEvent_MineHarvested) EventId() uint8 {
+	return InternalEventId_MineHarvested
+}
+
+func (*InternalEvent_CommandChanged) EventId() uint8 {
+	return InternalEventId_CommandChanged
+}
+
+func (*InternalEvent_BuildingPlaced) EventId() uint8 {
+	return InternalEventId_BuildingPlaced
+}
+
+func (*InternalEvent_UnitPaid) EventId() uint8 {
+	return InternalEventId_UnitPaid
+}
+
+func (*InternalEvent_PlayerEliminated) EventId() uint8 {
+	return InternalEventId_PlayerEliminated
+}
+
+func (*InternalEvent_MineDepleted) EventId() uint8 {
+	return InternalEventId_MineDepleted
+}
+
+func (*InternalEvent_Cancelled) EventId() uint8 {
+	return InternalEventId_Cancelled
+}
+
+func (*InternalEvent_Demolished) EventId() uint8 {
+	return InternalEventId_Demolished
+}
+
 type SetFieldHandler func(table arch.TableSchema, rowKey lib.RowKey, columnName string, value []byte)
 
 type (
@@ -159,9 +330,18 @@ type (
 	Initialization            = archmod.ActionData_Initialize
 	UnitCreation              = archmod.ActionData_CreateUnit
 	UnitAssignation           = archmod.ActionData_AssignUnit
+	UnitsAssignation          = archmod.ActionData_AssignUnits
 	BuildingPlacement         = archmod.ActionData_PlaceBuilding
+	Surrender                 = archmod.ActionData_Surrender
+	PauseRequest              = archmod.ActionData_RequestPause
+	Resumption                = archmod.ActionData_Resume
 	UnitPrototypeAddition     = archmod.ActionData_AddUnitPrototype
 	BuildingPrototypeAddition = archmod.ActionData_AddBuildingPrototype
+	DamageSetting             = archmod.ActionData_SetDamage
+	MineReserveSetting        = archmod.ActionData_SetMineReserve
+	TerrainSetting            = archmod.ActionData_SetTerrain
+	UnitCancellation          = archmod.ActionData_CancelUnit
+	BuildingDemolition        = archmod.ActionData_DemolishBuilding
 )
 
 var (
@@ -176,6 +356,7 @@ var (
 	ErrBuildingPrototypeLimitReached = errors.New("building prototype limit reached")
 	ErrInvalidPlayerId               = errors.New("invalid player id")
 	ErrInvalidUnitId                 = errors.New("invalid unit id")
+	ErrEmptyUnitMask                 = errors.New("empty unit mask")
 	ErrInvalidBuildingId             = errors.New("invalid building id")
 	ErrInvalidUnitType               = errors.New("invalid unit type")
 	ErrInvalidBuildingType           = errors.New("invalid building type")
@@ -184,6 +365,18 @@ var (
 	ErrMainBuildingDestroyed         = errors.New("player's main building destroyed")
 	ErrAreaNotBuildable              = errors.New("area not buildable")
 	ErrUnitDead                      = errors.New("unit dead")
+	ErrPlayerEliminated              = errors.New("player eliminated")
+	ErrGameOver                      = errors.New("game over")
+	ErrPaused                        = errors.New("paused")
+	ErrNotPaused                     = errors.New("not paused")
+	ErrPausedByOtherPlayer           = errors.New("paused by another player")
+	ErrNotResourceMine               = errors.New("not a resource mine")
+	ErrInvalidPosition               = errors.New("invalid position")
+	ErrInvalidTerrainType            = errors.New("invalid terrain type")
+	ErrTileOccupied                  = errors.New("tile occupied")
+	ErrUnitNotCancellable            = errors.New("unit not cancellable")
+	ErrInvalidRefundPercent          = errors.New("invalid refund percent")
+	ErrBuildingNotDemolishable       = errors.New("building not demolishable")
 )
 
 var (
@@ -194,35 +387,102 @@ var (
 	TableId_Buildings, _          = archmod.TableSchemas.TableIdFromName("Buildings")
 	TableId_UnitPrototypes, _     = archmod.TableSchemas.TableIdFromName("UnitPrototypes")
 	TableId_BuildingPrototypes, _ = archmod.TableSchemas.TableIdFromName("BuildingPrototypes")
+	TableId_DamageMatrix, _       = archmod.TableSchemas.TableIdFromName("DamageMatrix")
 )
 
+type eventSubscription struct {
+	id      uint64
+	handler InternalEventHandler
+}
+
 type Core struct {
 	arch.BaseCore
-	eventHandler    InternalEventHandler
-	setFieldHandler SetFieldHandler
+	eventSubscriptions   []eventSubscription
+	lastSubscriptionId   uint64
+	eventsMuted          bool
+	setFieldHandler      SetFieldHandler
+	defaultTicksPerBlock uint64
+	rowCache             *rowCache
+	unitIndex            *unitIndex
 }
 
 var _ archmod.IActions = &Core{}
 
+// Returns the number of ticks run per block as set at initialization. Before the match is
+// initialized it returns the default set with SetDefaultTicksPerBlock, or DefaultTicksPerBlock.
 func (c *Core) TicksPerBlock() uint64 {
-	return 1
+	if c.KV() != nil {
+		if ticksPerBlock := c.GetMeta().GetTicksPerBlock(); ticksPerBlock != 0 {
+			return uint64(ticksPerBlock)
+		}
+	}
+	if c.defaultTicksPerBlock != 0 {
+		return c.defaultTicksPerBlock
+	}
+	return DefaultTicksPerBlock
+}
+
+// Sets the ticks per block assumed before the match is initialized. Clients use it to schedule
+// sub-ticks before they have synced the Meta table.
+func (c *Core) SetDefaultTicksPerBlock(ticksPerBlock uint64) {
+	c.defaultTicksPerBlock = ticksPerBlock
 }
 
+// Returns the index of the current sub-tick, not counting the ticks elapsed while the match was
+// paused, so timers measured against it do not advance during a pause.
+// Once the block ticks have run the in-block tick index is left at TicksPerBlock. Actions applied
+// after them see the first sub-tick of the block, as they do on chain, where every call runs on a
+// fresh core.
 func (c *Core) AbsSubTickIndex() uint32 {
-	return uint32(c.BlockNumber()*c.TicksPerBlock() + c.InBlockTickIndex())
+	inBlockTickIndex := c.InBlockTickIndex() % c.TicksPerBlock()
+	absSubTickIndex := uint32(c.BlockNumber()*c.TicksPerBlock() + inBlockTickIndex)
+	return absSubTickIndex - c.GetMeta().GetPausedTicks()
+}
+
+// Registers a handler called with every internal event. Handlers are called in the order they
+// subscribed. The returned function cancels the subscription.
+func (c *Core) Subscribe(handler InternalEventHandler) func() {
+	c.lastSubscriptionId++
+	id := c.lastSubscriptionId
+	c.eventSubscriptions = append(c.eventSubscriptions, eventSubscription{id: id, handler: handler})
+	return func() {
+		// Build a new slice so an emission in progress keeps iterating over the old one
+		subscriptions := make([]eventSubscription, 0, len(c.eventSubscriptions))
+		for _, subscription := range c.eventSubscriptions {
+			if subscription.id != id {
+				subscriptions = append(subscriptions, subscription)
+			}
+		}
+		c.eventSubscriptions = subscriptions
+	}
 }
 
-func (c *Core) SetEventHandler(handler InternalEventHandler) {
-	c.eventHandler = handler
+// Registers a handler called with the internal events of type E, e.g. *InternalEvent_Shot.
+// The returned function cancels the subscription.
+func SubscribeTo[E InternalEvent](c *Core, handler func(event E)) func() {
+	return c.Subscribe(func(event InternalEvent) {
+		if e, ok := event.(E); ok {
+			handler(e)
+		}
+	})
+}
+
+// Stops internal events from reaching subscribers while muted, e.g. while simulating.
+func (c *Core) SetEventsMuted(muted bool) {
+	c.eventsMuted = muted
 }
 
-func (c *Core) EventHandler() InternalEventHandler {
-	return c.eventHandler
+func (c *Core) EventsMuted() bool {
+	return c.eventsMuted
 }
 
-func (c *Core) emitInternalEvent(eventId uint8, data interface{}) {
-	if c.eventHandler != nil && !c.Rebasing() {
-		c.eventHandler(eventId, data)
+func (c *Core) emitInternalEvent(event InternalEvent) {
+	if len(c.eventSubscriptions) == 0 || c.eventsMuted || c.Rebasing() {
+		return
+	}
+	event.setTickIndex(c.AbsSubTickIndex())
+	for _, subscription := range c.eventSubscriptions {
+		subscription.handler(event)
 	}
 }
 
@@ -244,11 +504,27 @@ func (c *Core) SetFieldCallback(tableId lib.TableId, rowKey lib.RowKey, columnIn
 }
 
 func (c *Core) GetMeta() *datamod.MetaRow {
-	return datamod.NewMetaWithParent(c.Datastore(), c, TableId_Meta).Get()
+	if c.rowCache != nil && c.rowCache.meta != nil {
+		return c.rowCache.meta
+	}
+	meta := datamod.NewMetaWithParent(c.Datastore(), c, TableId_Meta).Get()
+	if c.rowCache != nil {
+		c.rowCache.meta = meta
+	}
+	return meta
 }
 
 func (c *Core) GetPlayer(playerId uint8) *datamod.PlayersRow {
-	return datamod.NewPlayersWithParent(c.Datastore(), c, TableId_Players).Get(playerId)
+	if c.rowCache != nil {
+		if player, ok := c.rowCache.players[playerId]; ok {
+			return player
+		}
+	}
+	player := datamod.NewPlayersWithParent(c.Datastore(), c, TableId_Players).Get(playerId)
+	if c.rowCache != nil {
+		c.rowCache.players[playerId] = player
+	}
+	return player
 }
 
 func (c *Core) GetBoardTile(x, y uint16) *datamod.BoardRow {
@@ -273,7 +549,16 @@ func (c *Core) GetBuildingObject(playerId uint8, buildingId uint8) BuildingObjec
 }
 
 func (c *Core) GetUnit(playerId uint8, unitId uint8) *datamod.UnitsRow {
-	return datamod.NewUnitsWithParent(c.Datastore(), c, TableId_Units).Get(playerId, unitId)
+	if c.rowCache != nil {
+		if unit, ok := c.rowCache.units[unitRowCacheKey(playerId, unitId)]; ok {
+			return unit
+		}
+	}
+	unit := datamod.NewUnitsWithParent(c.Datastore(), c, TableId_Units).Get(playerId, unitId)
+	if c.rowCache != nil {
+		c.rowCache.units[unitRowCacheKey(playerId, unitId)] = unit
+	}
+	return unit
 }
 
 func (c *Core) GetUnitObject(playerId uint8, uintId uint8) UnitObjectWithRow {
@@ -290,13 +575,40 @@ func (c *Core) GetUnitObject(playerId uint8, uintId uint8) UnitObjectWithRow {
 }
 
 func (c *Core) GetUnitPrototype(unitTypeId uint8) *datamod.UnitPrototypesRow {
-	return datamod.NewUnitPrototypesWithParent(c.Datastore(), c, TableId_UnitPrototypes).Get(unitTypeId)
+	if c.rowCache != nil {
+		if prototype, ok := c.rowCache.unitPrototypes[unitTypeId]; ok {
+			return prototype
+		}
+	}
+	prototype := datamod.NewUnitPrototypesWithParent(c.Datastore(), c, TableId_UnitPrototypes).Get(unitTypeId)
+	if c.rowCache != nil {
+		c.rowCache.unitPrototypes[unitTypeId] = prototype
+	}
+	return prototype
 }
 
 func (c *Core) GetBuildingPrototype(prototypeId uint8) *datamod.BuildingPrototypesRow {
 	return datamod.NewBuildingPrototypesWithParent(c.Datastore(), c, TableId_BuildingPrototypes).Get(prototypeId)
 }
 
+func (c *Core) GetDamageMatrixEntry(attackerType uint8, targetType uint8) *datamod.DamageMatrixRow {
+	return datamod.NewDamageMatrixWithParent(c.Datastore(), c, TableId_DamageMatrix).Get(attackerType, targetType)
+}
+
+// Returns the damage a unit of the given attacker prototype deals to a unit of the given target prototype.
+// Damage matrix entries take precedence over the attacker's per-layer strength.
+// The matrix is keyed by unit prototypes only, so shots at buildings always use the land strength.
+func (c *Core) GetUnitAttackStrength(attackerType uint8, targetType uint8) uint8 {
+	if entry := c.GetDamageMatrixEntry(attackerType, targetType); entry.GetIsSet() {
+		return entry.GetStrength()
+	}
+	var (
+		attackerProto = c.GetUnitPrototype(attackerType)
+		targetProto   = c.GetUnitPrototype(targetType)
+	)
+	return GetAttackStrength(attackerProto, LayerId(targetProto.GetLayer()))
+}
+
 type (
 	PlayerFilter   func(playerId uint8, player *datamod.PlayersRow) bool
 	BuildingFilter func(playerId, buildingId uint8, building *datamod.BuildingsRow) bool
@@ -367,7 +679,8 @@ func (c *Core) ForEachBuilding(playerId uint8, forEach func(buildingId uint8, bu
 
 func (c *Core) ForEachUnit(playerId uint8, forEach func(unitId uint8, unit *datamod.UnitsRow)) {
 	nUnits := c.GetPlayer(playerId).GetUnitCount()
-	for unitId := uint8(1); unitId < nUnits+1; unitId++ {
+	for i := 1; i <= int(nUnits); i++ {
+		unitId := uint8(i)
 		forEach(unitId, c.GetUnit(playerId, unitId))
 	}
 }
@@ -427,8 +740,7 @@ func (c *Core) GetBuildingArea(playerId uint8, buildingId uint8) image.Rectangle
 }
 
 func (c *Core) GetMainBuilding(playerId uint8) *datamod.BuildingsRow {
-	// First building is the main building
-	return c.GetBuilding(playerId, 1)
+	return c.GetBuilding(playerId, MainBuildingId)
 }
 
 func (c *Core) GetMainBuildingPosition(playerId uint8) image.Point {
@@ -437,7 +749,7 @@ func (c *Core) GetMainBuildingPosition(playerId uint8) image.Point {
 }
 
 func (c *Core) GetMainBuildingArea(playerId uint8) image.Rectangle {
-	return c.GetBuildingArea(playerId, 1)
+	return c.GetBuildingArea(playerId, MainBuildingId)
 }
 
 func (c *Core) GetSpawnArea(playerId uint8) image.Rectangle {
@@ -465,7 +777,7 @@ func (c *Core) GetSpawnPoint(layer LayerId, playerId uint8) (image.Point, bool)
 	for x := uint16(spawnArea.Min.X); x < uint16(spawnArea.Max.X); x++ {
 		for y := uint16(spawnArea.Min.Y); y < uint16(spawnArea.Max.Y); y++ {
 			tile := c.GetBoardTile(x, y)
-			if IsTileEmptyAllLayers(tile) {
+			if IsTileEmptyAllLayers(tile) && GetTileTerrain(tile).IsPassable(layer) {
 				distance := DistanceToArea(nearestTile, mainBuildingArea)
 				if nearestDistance == -1 || distance < nearestDistance {
 					nearestTile = image.Point{int(x), int(y)}
@@ -515,35 +827,52 @@ func (c *Core) GetNearest(iter IIterator_Uint8, area image.Rectangle) ObjectMatc
 	return match
 }
 
-func (c *Core) GetNearestEnemyUnits(playerId uint8, position image.Point, filters ...UnitFilter) []PlayerObjectMatchByDistance {
+// Returns true if both players are the same player or belong to the same team.
+// Players with a nil team id are only allied with themselves.
+func (c *Core) AreAllies(playerIdA, playerIdB uint8) bool {
+	if playerIdA == playerIdB {
+		return true
+	}
+	teamIdA := c.GetPlayer(playerIdA).GetTeamId()
+	return teamIdA != NilTeamId && teamIdA == c.GetPlayer(playerIdB).GetTeamId()
+}
+
+// Returns the nearest enemy unit on the board on each layer within the given range of position
+// that passes the filters. Ties are broken by the lowest player id and then the lowest unit id.
+// During a tick it queries the unit index instead of scanning every enemy unit.
+func (c *Core) GetNearestEnemyUnitsInRange(playerId uint8, position image.Point, _range int, filters ...UnitFilter) []PlayerObjectMatchByDistance {
+	index := c.unitIndex
+	if index == nil {
+		index = c.buildUnitIndex()
+	}
 	matches := make([]PlayerObjectMatchByDistance, LayerId_Count)
-	nPlayers := c.GetMeta().GetPlayerCount()
-	for enemyPlayerId := uint8(1); enemyPlayerId < nPlayers+1; enemyPlayerId++ {
-		if enemyPlayerId == playerId {
-			continue
+	index.forEachInRange(position, _range, func(candidate indexedUnit, distance int) {
+		if match := matches[candidate.layer]; !match.IsNil() {
+			if distance > match.Distance {
+				return
+			}
+			if distance == match.Distance &&
+				(candidate.playerId > match.PlayerId || candidate.playerId == match.PlayerId && candidate.unitId > match.ObjectId) {
+				return
+			}
 		}
-		iter := c.IterUnits(enemyPlayerId, filters...)
-		for iter.Next() {
-			var (
-				unitId, _unit = iter.Value()
-				unit          = _unit.(*datamod.UnitsRow)
-				unitPosition  = GetPositionAsPoint(unit)
-				protoId       = unit.GetUnitType()
-				proto         = c.GetUnitPrototype(protoId)
-				layer         = proto.GetLayer()
-				distance      = Distance(position, unitPosition)
-			)
-			if matches[layer].IsNil() || distance < matches[layer].Distance {
-				matches[layer] = PlayerObjectMatchByDistance{
-					PlayerId: enemyPlayerId,
-					ObjectMatchByDistance: ObjectMatchByDistance{
-						ObjectId: unitId,
-						Distance: distance,
-					},
-				}
+		if c.AreAllies(playerId, candidate.playerId) {
+			return
+		}
+		unit := c.GetUnit(candidate.playerId, candidate.unitId)
+		for _, filter := range filters {
+			if !filter(candidate.playerId, candidate.unitId, unit) {
+				return
 			}
 		}
-	}
+		matches[candidate.layer] = PlayerObjectMatchByDistance{
+			PlayerId: candidate.playerId,
+			ObjectMatchByDistance: ObjectMatchByDistance{
+				ObjectId: candidate.unitId,
+				Distance: distance,
+			},
+		}
+	})
 	return matches
 }
 
@@ -566,15 +895,13 @@ func (c *Core) IsBuildableArea(area image.Rectangle) bool {
 	nPlayers := c.GetMeta().GetPlayerCount()
 	for playerId := uint8(1); playerId < nPlayers+1; playerId++ {
 		if area.Overlaps(c.GetSpawnArea(playerId)) {
-			fmt.Println("Area overlaps spawn area")
 			return false
 		}
 	}
 	for x := uint16(area.Min.X); x < uint16(area.Max.X); x++ {
 		for y := uint16(area.Min.Y); y < uint16(area.Max.Y); y++ {
 			tile := c.GetBoardTile(x, y)
-			if !IsTileEmpty(tile, LayerId_Land) {
-				fmt.Println("Area overlaps non-empty tile")
+			if !IsTileEmpty(tile, LayerId_Land) || !GetTileTerrain(tile).IsBuildable() {
 				return false
 			}
 		}
@@ -652,11 +979,14 @@ func (c *Core) setUnitSpawning(obj UnitObjectWithRow) {
 		computeCost = proto.GetComputeCost()
 	)
 	payPointer := obj.ObjectId()
-	player.SetUnitPayQueuePointer(utils.SafeAddUint8(payPointer, 1))
-	addComputeDemand(player, computeCost)
+	player.SetUnitPayQueuePointer(c.nextUnitId(obj.PlayerId(), payPointer))
 	if state == UnitState_Unpaid {
 		// Unit may have died prematurely due to a purge
+		addComputeDemand(player, computeCost)
 		c.setUnitState(obj, UnitState_Spawning)
+		c.emitInternalEvent(&InternalEvent_UnitPaid{
+			Unit: obj.Object(),
+		})
 	}
 	unit.SetTimestamp(c.AbsSubTickIndex())
 }
@@ -667,7 +997,7 @@ func (c *Core) setUnitSpawned(obj UnitObjectWithRow) {
 	c.setUnitState(obj, UnitState_Active)
 	obj.Unit().SetTimestamp(timeNow)
 
-	c.emitInternalEvent(InternalEventId_Spawned, &InternalEvent_Spawned{
+	c.emitInternalEvent(&InternalEvent_Spawned{
 		Unit: obj.Object(),
 	})
 }
@@ -678,16 +1008,44 @@ func (c *Core) setUnitDead(obj UnitObjectWithRow) {
 		unit        = obj.Unit()
 		proto       = c.GetUnitPrototype(unit.GetUnitType())
 		computeCost = proto.GetComputeCost()
-		tile        = c.GetBoardTile(unit.GetX(), unit.GetY())
 		layer       = LayerId(proto.GetLayer())
 	)
+	state := UnitState(unit.GetState())
+	if state != UnitState_Inactive {
+		// Inactive units are not on the board
+		c.emptyUnitTile(GetPositionAsPoint(unit), layer, obj.PlayerId(), obj.ObjectId())
+	}
 	c.setUnitState(obj, UnitState_Dead)
-	EmptyUnitTile(tile, layer)
+	unit.SetTimestamp(c.AbsSubTickIndex())
 
 	// Execute death side-effects
-	subComputeDemand(player, computeCost)
+	if state != UnitState_Unpaid {
+		// Unpaid units do not add to the compute demand yet
+		subComputeDemand(player, computeCost)
+	}
 	c.resetBuildingProcessIfAny(obj)
-	c.emitInternalEvent(InternalEventId_Killed, &InternalEvent_Killed{
+	c.emitInternalEvent(&InternalEvent_Killed{
+		Unit: obj.Object(),
+	})
+}
+
+// Removes an unpaid or spawning unit from the board, refunding it if it was already paid for.
+func (c *Core) setUnitCancelled(obj UnitObjectWithRow) {
+	var (
+		player = c.GetPlayer(obj.PlayerId())
+		unit   = obj.Unit()
+		proto  = c.GetUnitPrototype(unit.GetUnitType())
+		layer  = LayerId(proto.GetLayer())
+		state  = UnitState(unit.GetState())
+	)
+	c.emptyUnitTile(GetPositionAsPoint(unit), layer, obj.PlayerId(), obj.ObjectId())
+	c.setUnitState(obj, UnitState_Dead)
+	unit.SetTimestamp(c.AbsSubTickIndex())
+	if state == UnitState_Spawning {
+		addResource(player, proto.GetResourceCost())
+		subComputeDemand(player, proto.GetComputeCost())
+	}
+	c.emitInternalEvent(&InternalEvent_Cancelled{
 		Unit: obj.Object(),
 	})
 }
@@ -726,6 +1084,11 @@ func (c *Core) setUnitCommand(obj UnitObjectWithRow, command UnitCommandData) {
 	unit.SetCommand(command.Uint64())
 	unit.SetCommandExtra(0)
 	unit.SetCommandMeta(0)
+
+	c.emitInternalEvent(&InternalEvent_CommandChanged{
+		Unit:    obj.Object(),
+		Command: command.Uint64(),
+	})
 }
 
 func (c *Core) setBuildingState(obj BuildingObjectWithRow, state BuildingState) {
@@ -761,17 +1124,48 @@ func (c *Core) setBuildingBuilt(obj BuildingObjectWithRow) {
 		if proto.GetIsArmory() {
 			addArmory(player)
 		}
-		if obj.ObjectId() == 1 {
+		if obj.ObjectId() == MainBuildingId {
 			addResource(player, resourceCapacity)
 		}
 	}
 
-	c.emitInternalEvent(InternalEventId_Built, &InternalEvent_Built{
+	c.emitInternalEvent(&InternalEvent_Built{
 		Building: obj.Object(),
 	})
 }
 
+func (c *Core) setBuildingDepleted(obj BuildingObjectWithRow) {
+	c.setBuildingState(obj, BuildingState_Depleted)
+	c.emitInternalEvent(&InternalEvent_MineDepleted{
+		Mine: obj.Object(),
+	})
+}
+
 func (c *Core) setBuildingDestroyed(obj BuildingObjectWithRow) {
+	c.removeBuilding(obj)
+	c.emitInternalEvent(&InternalEvent_Destroyed{
+		Building: obj.Object(),
+	})
+}
+
+// Removes a building that is demolished by its player and refunds part of its cost.
+func (c *Core) setBuildingDemolished(obj BuildingObjectWithRow) {
+	var (
+		player = c.GetPlayer(obj.PlayerId())
+		proto  = c.GetBuildingPrototype(obj.Building().GetBuildingType())
+		refund = uint16(uint32(proto.GetResourceCost()) * uint32(c.GetMeta().GetDemolitionRefundPercent()) / 100)
+	)
+	c.removeBuilding(obj)
+	// Refunded after the storage of the building is removed so it is capped by the remaining storage
+	addResource(player, refund)
+	c.emitInternalEvent(&InternalEvent_Demolished{
+		Building: obj.Object(),
+		Refund:   refund,
+	})
+}
+
+// Sets the building as destroyed, frees its tiles and reverts its side effects on the player.
+func (c *Core) removeBuilding(obj BuildingObjectWithRow) {
 	var (
 		player   = c.GetPlayer(obj.PlayerId())
 		building = obj.Building()
@@ -794,10 +1188,6 @@ func (c *Core) setBuildingDestroyed(obj BuildingObjectWithRow) {
 	if proto.GetIsArmory() {
 		subArmory(player)
 	}
-
-	c.emitInternalEvent(InternalEventId_Destroyed, &InternalEvent_Destroyed{
-		Building: obj.Object(),
-	})
 }
 
 func (c *Core) tickPlayer(playerId uint8) {
@@ -810,13 +1200,29 @@ func (c *Core) tickPlayer(playerId uint8) {
 	c.payForAndAssignBuildings(playerId)
 }
 
+// Moves the unit pay pointer past the units at the head of the pay queue that are not waiting to be
+// paid for: dead units, which were cancelled or purged before being paid for, and live units whose
+// ids were skipped over when creating a unit.
+func (c *Core) skipSettledUnitsInPayQueue(playerId uint8) {
+	var (
+		player     = c.GetPlayer(playerId)
+		payPointer = player.GetUnitPayQueuePointer()
+		tail       = c.nextUnitId(playerId, player.GetLastUnitId())
+	)
+	for payPointer != tail && UnitState(c.GetUnit(playerId, payPointer).GetState()) != UnitState_Unpaid {
+		payPointer = c.nextUnitId(playerId, payPointer)
+	}
+	player.SetUnitPayQueuePointer(payPointer)
+}
+
 func (c *Core) payForUnits(playerId uint8) {
+	c.skipSettledUnitsInPayQueue(playerId)
 	var (
 		player     = c.GetPlayer(playerId)
-		nUnits     = player.GetUnitCount()
 		payPointer = player.GetUnitPayQueuePointer()
+		tail       = c.nextUnitId(playerId, player.GetLastUnitId())
 	)
-	if payPointer <= nUnits {
+	if payPointer != tail {
 		var (
 			unitId         = payPointer
 			obj            = c.GetUnitObject(playerId, unitId)
@@ -938,6 +1344,7 @@ func (c *Core) placeBuilding(playerId uint8, prototypeId uint8, position image.P
 		BuildingState_Unpaid.Uint8(),
 		maxIntegrity,
 		0,
+		proto.GetResourceReserve(),
 	)
 
 	for x := uint16(position.X); x < uint16(position.X+size.X); x++ {
@@ -947,9 +1354,56 @@ func (c *Core) placeBuilding(playerId uint8, prototypeId uint8, position image.P
 		}
 	}
 
+	c.emitInternalEvent(&InternalEvent_BuildingPlaced{
+		Building: c.GetBuildingObject(playerId, buildingId).Object(),
+	})
+
 	return buildingId
 }
 
+// Returns the id that follows the given one in the player's unit id ring. Once MaxUnitId is reached
+// ids wrap around to the first purgeable id, so unpurgeable units keep their ids for the whole match.
+func (c *Core) nextUnitId(playerId uint8, unitId uint8) uint8 {
+	if unitId < MaxUnitId {
+		return unitId + 1
+	}
+	return c.GetPlayer(playerId).GetUnpurgeableUnitCount() + 1
+}
+
+// Returns the first id after the player's last unit id that can be assigned to a new unit, skipping
+// over live units in the id ring. Returns NilUnitId if the unit limit is reached.
+func (c *Core) nextFreeUnitId(playerId uint8) uint8 {
+	var (
+		player     = c.GetPlayer(playerId)
+		nUnits     = player.GetUnitCount()
+		payPointer = player.GetUnitPayQueuePointer()
+		unitId     = player.GetLastUnitId()
+	)
+	for i := 0; i < int(MaxUnitId); i++ {
+		unitId = c.nextUnitId(playerId, unitId)
+		if c.nextUnitId(playerId, unitId) == payPointer {
+			// The pay queue would wrap around onto itself
+			return NilUnitId
+		}
+		if unitId > nUnits || c.isUnitIdReusable(playerId, unitId) {
+			return unitId
+		}
+	}
+	return NilUnitId
+}
+
+// Returns true if the id of a previously created unit can be assigned to a new unit.
+func (c *Core) isUnitIdReusable(playerId uint8, unitId uint8) bool {
+	if unitId <= c.GetPlayer(playerId).GetUnpurgeableUnitCount() {
+		return false
+	}
+	unit := c.GetUnit(playerId, unitId)
+	if UnitState(unit.GetState()) != UnitState_Dead {
+		return false
+	}
+	return c.AbsSubTickIndex() >= unit.GetTimestamp()+UnitIdReuseDelay
+}
+
 func (c *Core) createUnit(playerId uint8, prototypeId uint8, position image.Point) uint8 {
 	if !position.In(c.GetSpawnArea(playerId)) {
 		// Position is not in spawn area
@@ -959,7 +1413,7 @@ func (c *Core) createUnit(playerId uint8, prototypeId uint8, position image.Poin
 	var (
 		player       = c.GetPlayer(playerId)
 		nUnits       = player.GetUnitCount()
-		unitId       = utils.SafeAddUint8(nUnits, 1)
+		unitId       = c.nextFreeUnitId(playerId)
 		proto        = c.GetUnitPrototype(prototypeId)
 		layer        = LayerId(proto.GetLayer())
 		maxIntegrity = proto.GetMaxIntegrity()
@@ -967,7 +1421,7 @@ func (c *Core) createUnit(playerId uint8, prototypeId uint8, position image.Poin
 		tile         = c.GetBoardTile(uint16(position.X), uint16(position.Y))
 	)
 
-	if unitId == nUnits {
+	if unitId == NilUnitId {
 		// Unit limit reached
 		return NilUnitId
 	}
@@ -976,8 +1430,19 @@ func (c *Core) createUnit(playerId uint8, prototypeId uint8, position image.Poin
 		// Area is occupied
 		return NilUnitId
 	}
+	if !GetTileTerrain(tile).IsPassable(layer) {
+		// Unit cannot stand on the terrain
+		return NilUnitId
+	}
 
-	player.SetUnitCount(unitId)
+	if unitId > nUnits {
+		player.SetUnitCount(unitId)
+	} else {
+		c.emitInternalEvent(&InternalEvent_Recycled{
+			Unit: c.GetUnitObject(playerId, unitId).Object(),
+		})
+	}
+	player.SetLastUnitId(unitId)
 	var command UnitCommandData
 	if proto.GetIsWorker() {
 		command = NewWorkerCommandData(WorkerCommandType_Idle)
@@ -1000,7 +1465,7 @@ func (c *Core) createUnit(playerId uint8, prototypeId uint8, position image.Poin
 		false,
 	)
 
-	SetTileUnit(tile, layer, playerId, unitId)
+	c.setTileUnit(position, layer, playerId, unitId)
 
 	return unitId
 }
@@ -1036,6 +1501,25 @@ func (c *Core) setWorkerToGather(obj UnitObjectWithRow, targetBuildingId uint8)
 	c.assignUnit(obj, command)
 }
 
+// Sends the worker to gather from the nearest mine with resources left, or sets it to idle if there
+// is none.
+func (c *Core) setWorkerToNearestMine(obj UnitObjectWithRow) {
+	var (
+		workerPosition = GetPositionAsPoint(obj.Unit())
+		workerArea     = image.Rectangle{Min: workerPosition, Max: workerPosition.Add(image.Point{1, 1})}
+		iter           = c.IterBuildings(NilPlayerId, func(playerId, buildingId uint8, building *datamod.BuildingsRow) bool {
+			return BuildingState(building.GetState()) == BuildingState_Built &&
+				c.GetBuildingPrototype(building.GetBuildingType()).GetResourceMine() > 0
+		})
+		match = c.GetNearest(iter, workerArea)
+	)
+	if match.IsNil() {
+		c.setWorkerToIdle(obj)
+		return
+	}
+	c.setWorkerToGather(obj, match.ObjectId)
+}
+
 func (c *Core) setWorkerToBuild(obj UnitObjectWithRow, targetBuildingId uint8) {
 	command := NewWorkerCommandData(WorkerCommandType_Build)
 	command.SetTargetBuilding(obj.PlayerId(), targetBuildingId)
@@ -1047,6 +1531,9 @@ func (c *Core) unitCanMoveTo(position image.Point, layer LayerId) bool {
 		return false
 	}
 	tile := c.GetBoardTile(uint16(position.X), uint16(position.Y))
+	if !GetTileTerrain(tile).IsPassable(layer) {
+		return false
+	}
 	switch layer {
 	case LayerId_Hover:
 		// Workers cannot overlap with other workers
@@ -1070,6 +1557,15 @@ func (c *Core) unitCanMoveTo(position image.Point, layer LayerId) bool {
 	return true
 }
 
+// Returns the area around a waypoint within which it is considered reached, so units do not wait for
+// the waypoint tile itself to be free.
+func waypointArea(waypoint image.Point) image.Rectangle {
+	return image.Rectangle{
+		Min: waypoint.Sub(image.Point{1, 1}),
+		Max: waypoint.Add(image.Point{2, 2}),
+	}
+}
+
 func (c *Core) moveUnitToTarget(obj UnitObjectWithRow, targetArea image.Rectangle) image.Point {
 	var (
 		unit     = obj.Unit()
@@ -1086,11 +1582,7 @@ func (c *Core) moveUnitToTarget(obj UnitObjectWithRow, targetArea image.Rectangl
 		path := NewCommandPath(unit.GetCommandExtra(), unit.GetCommandMeta())
 		if path.HasPath() {
 			for path.Pointer() < path.PathLen() {
-				pathPoint := path.CurrentPoint()
-				pathPointArea := image.Rectangle{
-					Min: pathPoint.Sub(image.Point{1, 1}),
-					Max: pathPoint.Add(image.Point{2, 2}),
-				}
+				pathPointArea := waypointArea(path.CurrentPoint())
 				if position.In(pathPointArea) {
 					path.IncPointer()
 				} else {
@@ -1102,76 +1594,19 @@ func (c *Core) moveUnitToTarget(obj UnitObjectWithRow, targetArea image.Rectangl
 		}
 	}
 
-	_, nextPosition, _, _ := c.greedyPathFind(layer, position, targetArea, 2, 0)
+	nextPosition := c.pathFind(layer, position, targetArea)
 	if nextPosition.Eq(position) {
 		return position
 	}
 
-	curTile := c.GetBoardTile(uint16(position.X), uint16(position.Y))
-	nextTile := c.GetBoardTile(uint16(nextPosition.X), uint16(nextPosition.Y))
-	EmptyUnitTile(curTile, layer)
-	SetTileUnit(nextTile, layer, obj.PlayerId(), obj.ObjectId())
+	c.emptyUnitTile(position, layer, obj.PlayerId(), obj.ObjectId())
+	c.setTileUnit(nextPosition, layer, obj.PlayerId(), obj.ObjectId())
 	unit.SetX(uint16(nextPosition.X))
 	unit.SetY(uint16(nextPosition.Y))
 
 	return nextPosition
 }
 
-func (c *Core) greedyPathFind(layer LayerId, position image.Point, targetArea image.Rectangle, depth int, pathLength int) (image.Point, image.Point, int, int) {
-	var (
-		bestStep       = image.Point{}
-		bestPosition   = position
-		bestDistance   = DistanceToArea(position, targetArea)
-		bestPathLength = pathLength
-	)
-	if bestDistance == 0 || depth == 0 {
-		return bestStep, bestPosition, bestDistance, pathLength
-	}
-
-	steps := []image.Point{
-		// Prioritize orthogonal steps
-		{0, -1}, {1, 0}, {0, 1}, {-1, 0},
-		{1, -1}, {1, 1}, {-1, 1}, {-1, -1},
-	}
-	for _, _step := range steps {
-		_position := position.Add(_step)
-		if !c.unitCanMoveTo(_position, layer) {
-			continue
-		}
-		_, _, _distance, _pathLength := c.greedyPathFind(layer, _position, targetArea, depth-1, pathLength+1)
-
-		replace := false
-		if _distance < bestDistance {
-			// Shorter distance
-			replace = true
-		} else if _distance == bestDistance {
-			if _pathLength < bestPathLength {
-				// Same distance but shorter path
-				replace = true
-			} else if ManhattanDistanceToArea(_position, targetArea) < ManhattanDistanceToArea(bestPosition, targetArea) {
-				// Same distance and path length but closer to target in manhattan distance
-				if _pathLength == bestPathLength {
-					replace = true
-				} else if bestStep.Eq(image.Point{0, 0}) && _pathLength == bestPathLength+1 {
-					// New path length will be the same as not moving but manhattan distance is smaller
-					replace = true
-				}
-			}
-		}
-
-		if replace {
-			bestStep = _step
-			bestPosition = _position
-			bestDistance = _distance
-			bestPathLength = _pathLength
-			if depth == 1 && _distance == 0 {
-				break
-			}
-		}
-	}
-	return bestStep, bestPosition, bestDistance, bestPathLength
-}
-
 func (c *Core) GetUnitToFireAt(obj UnitObjectWithRow) PlayerObjectMatchByDistance {
 	var (
 		playerId        = obj.PlayerId()
@@ -1181,7 +1616,7 @@ func (c *Core) GetUnitToFireAt(obj UnitObjectWithRow) PlayerObjectMatchByDistanc
 		protoId         = fighter.GetUnitType()
 		proto           = c.GetUnitPrototype(protoId)
 	)
-	matches := c.GetNearestEnemyUnits(playerId, fighterPosition, func(playerId, unitId uint8, unit *datamod.UnitsRow) bool {
+	matches := c.GetNearestEnemyUnitsInRange(playerId, fighterPosition, int(proto.GetAttackRange()), func(playerId, unitId uint8, unit *datamod.UnitsRow) bool {
 		unitState := UnitState(unit.GetState())
 		return unitState.HasSpawned() && !unitState.IsDeadOrInactive()
 	})
@@ -1196,7 +1631,8 @@ func (c *Core) GetUnitToFireAt(obj UnitObjectWithRow) PlayerObjectMatchByDistanc
 			// Hover units (workers) are the lowest priority
 			continue
 		}
-		strength := GetAttackStrength(proto, layer)
+		target := c.GetUnit(match.PlayerId, match.ObjectId)
+		strength := c.GetUnitAttackStrength(protoId, target.GetUnitType())
 		_range := proto.GetAttackRange()
 		if match.Distance > int(_range) {
 			continue
@@ -1217,23 +1653,21 @@ func (c *Core) shootUnit(attackerObj UnitObjectWithRow, targetObj UnitObjectWith
 		timeNow         = c.AbsSubTickIndex()
 		target          = targetObj.Unit()
 		targetProtoId   = target.GetUnitType()
-		targetProto     = c.GetUnitPrototype(targetProtoId)
 		targetIntegrity = target.GetIntegrity()
-		layer           = LayerId(targetProto.GetLayer())
 		attacker        = attackerObj.Unit()
 		attackerProtoId = attacker.GetUnitType()
-		attackerProto   = c.GetUnitPrototype(attackerProtoId)
-		attackStrength  = GetAttackStrength(attackerProto, layer)
+		attackStrength  = c.GetUnitAttackStrength(attackerProtoId, targetProtoId)
 	)
 	target.SetIntegrity(utils.SafeSubUint8(targetIntegrity, attackStrength))
 	attacker.SetTimestamp(timeNow)
-	c.emitInternalEvent(InternalEventId_Shot, &InternalEvent_Shot{
+	c.emitInternalEvent(&InternalEvent_Shot{
 		Attacker: attackerObj.Object(),
 		Target:   targetObj.Object(),
 	})
+	c.splashUnits(attackerObj, targetObj)
 
-	if target.GetIntegrity() == 0 {
-		// Target is destroyed, hold position
+	if target.GetIntegrity() == 0 && !FighterCommandData(attacker.GetCommand()).Type().IsAttackMoving() {
+		// Target is destroyed, hold position. Attack-moving units keep their command and resume moving.
 		attackerPosition := GetPositionAsPoint(attacker)
 		attackerCommand := NewFighterCommandData(FighterCommandType_HoldPosition)
 		attackerCommand.SetTargetPosition(attackerPosition)
@@ -1241,7 +1675,70 @@ func (c *Core) shootUnit(attackerObj UnitObjectWithRow, targetObj UnitObjectWith
 	}
 }
 
+// Damages every enemy unit on the target's layer within the attacker's splash radius of the target.
+// Damage falls off linearly by splashFalloff percent per tile of distance from the target.
+func (c *Core) splashUnits(attackerObj UnitObjectWithRow, targetObj UnitObjectWithRow) {
+	var (
+		attacker        = attackerObj.Unit()
+		attackerProtoId = attacker.GetUnitType()
+		attackerProto   = c.GetUnitPrototype(attackerProtoId)
+		splashRadius    = int(attackerProto.GetSplashRadius())
+		splashFalloff   = int(attackerProto.GetSplashFalloff())
+		target          = targetObj.Unit()
+		targetPosition  = GetPositionAsPoint(target)
+		targetProto     = c.GetUnitPrototype(target.GetUnitType())
+		layer           = targetProto.GetLayer()
+	)
+	if splashRadius == 0 {
+		return
+	}
+	index := c.unitIndex
+	if index == nil {
+		index = c.buildUnitIndex()
+	}
+	// Collect the splashed units first and damage them by player and unit id so the order shot
+	// events are emitted in does not depend on the order of the index buckets
+	splashed := make([]indexedUnit, 0)
+	index.forEachInRange(targetPosition, splashRadius, func(candidate indexedUnit, distance int) {
+		if candidate.layer != LayerId(layer) || c.AreAllies(attackerObj.PlayerId(), candidate.playerId) {
+			return
+		}
+		if candidate.playerId == targetObj.PlayerId() && candidate.unitId == targetObj.ObjectId() {
+			return
+		}
+		splashed = append(splashed, candidate)
+	})
+	slices.SortFunc(splashed, func(a, b indexedUnit) int {
+		if a.playerId != b.playerId {
+			return int(a.playerId) - int(b.playerId)
+		}
+		return int(a.unitId) - int(b.unitId)
+	})
+	for _, candidate := range splashed {
+		var (
+			unit           = c.GetUnit(candidate.playerId, candidate.unitId)
+			unitState      = UnitState(unit.GetState())
+			distance       = Distance(targetPosition, candidate.position)
+			attackStrength = int(c.GetUnitAttackStrength(attackerProtoId, unit.GetUnitType()))
+		)
+		if !unitState.HasSpawned() || unitState.IsDeadOrInactive() {
+			continue
+		}
+		damage := attackStrength * (100 - splashFalloff*distance) / 100
+		if damage <= 0 {
+			continue
+		}
+		unit.SetIntegrity(utils.SafeSubUint8(unit.GetIntegrity(), uint8(damage)))
+		c.emitInternalEvent(&InternalEvent_Shot{
+			Attacker: attackerObj.Object(),
+			Target:   c.GetUnitObject(candidate.playerId, candidate.unitId).Object(),
+			IsSplash: true,
+		})
+	}
+}
+
 func (c *Core) shootBuilding(attackerObj UnitObjectWithRow, targetObj BuildingObjectWithRow) {
+	// Buildings are not in the damage matrix and take the attacker's land strength
 	var (
 		layer           = LayerId_Land
 		target          = targetObj.Building()
@@ -1257,7 +1754,7 @@ func (c *Core) shootBuilding(attackerObj UnitObjectWithRow, targetObj BuildingOb
 	if integrity == 0 {
 		c.setBuildingDestroyed(targetObj)
 	}
-	c.emitInternalEvent(InternalEventId_Shot, &InternalEvent_Shot{
+	c.emitInternalEvent(&InternalEvent_Shot{
 		Attacker: attackerObj.Object(),
 		Target:   targetObj.Object(),
 	})
@@ -1307,7 +1804,7 @@ func (c *Core) tickUnitPreliminary(obj UnitObjectWithRow) bool {
 				tile := c.GetBoardTile(uint16(workerPosition.X), uint16(workerPosition.Y))
 				layer := LayerId(proto.GetLayer())
 				if IsTileEmpty(tile, layer) {
-					SetTileUnit(tile, layer, obj.PlayerId(), obj.ObjectId())
+					c.setTileUnit(workerPosition, layer, obj.PlayerId(), obj.ObjectId())
 					c.setUnitState(obj, UnitState_Active)
 				}
 			}
@@ -1316,9 +1813,8 @@ func (c *Core) tickUnitPreliminary(obj UnitObjectWithRow) bool {
 			workerPosition.Eq(c.GetWorkerPortPosition(obj.PlayerId())) &&
 			workerLoad == 0 {
 			// Deactivate worker if idle and at worker port with no load
-			tile := c.GetBoardTile(uint16(workerPosition.X), uint16(workerPosition.Y))
 			layer := LayerId(proto.GetLayer())
-			EmptyUnitTile(tile, layer)
+			c.emptyUnitTile(workerPosition, layer, obj.PlayerId(), obj.ObjectId())
 			c.setUnitState(obj, UnitState_Inactive)
 			return false
 		}
@@ -1347,6 +1843,10 @@ func (c *Core) tickWorkerAction(obj UnitObjectWithRow) bool {
 			}
 			addResource(player, workerLoad/penaltyDivisor)
 			worker.SetLoad(0)
+			c.emitInternalEvent(&InternalEvent_ResourceDeposited{
+				Unit:   obj.Object(),
+				Amount: workerLoad / penaltyDivisor,
+			})
 			return false
 		}
 		return true
@@ -1371,6 +1871,19 @@ func (c *Core) tickWorkerAction(obj UnitObjectWithRow) bool {
 		c.setWorkerToIdle(obj)
 		return true
 	}
+	if workerCommandType == WorkerCommandType_Gather &&
+		BuildingState(targetBuilding.GetState()) == BuildingState_Depleted {
+		// If assigned mine is depleted, move on to the nearest mine with resources left
+		c.setWorkerToNearestMine(obj)
+		return true
+	}
+	if workerCommandType == WorkerCommandType_Repair &&
+		(BuildingState(targetBuilding.GetState()) != BuildingState_Built ||
+			targetBuilding.GetIntegrity() >= targetProto.GetMaxIntegrity()) {
+		// If assigned building was destroyed or is fully repaired, set worker to idle
+		c.setWorkerToIdle(obj)
+		return true
+	}
 	if !workerPosition.In(targetArea) {
 		// If worker is not at target building, move on to the movement phase
 		return true
@@ -1388,8 +1901,26 @@ func (c *Core) tickWorkerAction(obj UnitObjectWithRow) bool {
 			return false
 		}
 		// Resources are ready, load them
+		var (
+			mine    = c.GetBuildingObject(targetPlayerId, targetBuildingId)
+			amount  = targetProto.GetResourceMine()
+			reserve = targetBuilding.GetResourceReserve()
+		)
 		targetBuilding.SetTimestamp(timeNow)
-		worker.SetLoad(targetProto.GetResourceMine())
+		if reserve > 0 {
+			// Mine has a limited reserve, load at most what is left
+			amount = uint8(min(uint16(amount), reserve))
+			targetBuilding.SetResourceReserve(reserve - uint16(amount))
+		}
+		worker.SetLoad(amount)
+		c.emitInternalEvent(&InternalEvent_MineHarvested{
+			Unit:   obj.Object(),
+			Mine:   mine.Object(),
+			Amount: amount,
+		})
+		if reserve > 0 && reserve == uint16(amount) {
+			c.setBuildingDepleted(mine)
+		}
 		return false
 	} else if workerCommandType == WorkerCommandType_Build {
 		// If worker is building, at target building, and building time has elapsed, build
@@ -1410,6 +1941,32 @@ func (c *Core) tickWorkerAction(obj UnitObjectWithRow) bool {
 		// Building is built, idle
 		c.setWorkerToIdle(obj)
 		return false
+	} else if workerCommandType == WorkerCommandType_Repair {
+		// If worker is repairing, at target building, and the repair cooldown has elapsed, repair
+		workerProto := c.GetUnitPrototype(worker.GetUnitType())
+		if timeNow-worker.GetTimestamp() < uint32(workerProto.GetRepairCooldown()) {
+			return false
+		}
+		var (
+			player             = c.GetPlayer(obj.PlayerId())
+			repairResourceCost = uint16(workerProto.GetRepairResourceCost())
+		)
+		if player.GetCurResource() < repairResourceCost {
+			// Not enough resources, wait
+			return false
+		}
+		subResource(player, repairResourceCost)
+		worker.SetTimestamp(timeNow)
+		var (
+			maxIntegrity = targetProto.GetMaxIntegrity()
+			integrity    = utils.Min(utils.SafeAddUint8(targetBuilding.GetIntegrity(), workerProto.GetRepairIntegrity()), maxIntegrity)
+		)
+		targetBuilding.SetIntegrity(integrity)
+		if integrity == maxIntegrity {
+			// Building is fully repaired, idle
+			c.setWorkerToIdle(obj)
+		}
+		return false
 	}
 
 	return true
@@ -1444,12 +2001,14 @@ func (c *Core) tickFighterAction(obj UnitObjectWithRow) bool {
 	}
 
 	targetMatch := c.GetUnitToFireAt(obj)
+	// Attack-moving units engage any enemy in range regardless of their prototype
+	engages := fighterProto.GetIsConfrontational() || fighterCommand.Type().IsAttackMoving()
 
 	deltaTime := c.AbsSubTickIndex() - fighter.GetTimestamp()
 	if deltaTime < uint32(fighterProto.GetAttackCooldown()) {
-		// Cooldown has not elapsed, move on to the movement phase unless the unit is confrontational and
+		// Cooldown has not elapsed, move on to the movement phase unless the unit engages and
 		// there is a target in range
-		if fighterProto.GetIsConfrontational() && !targetMatch.IsNil() {
+		if engages && !targetMatch.IsNil() {
 			return false
 		} else {
 			return true
@@ -1461,8 +2020,8 @@ func (c *Core) tickFighterAction(obj UnitObjectWithRow) bool {
 		targetUnit := c.GetUnitObject(targetMatch.PlayerId, targetMatch.ObjectId)
 		c.shootUnit(obj, targetUnit)
 
-		if targetUnit.Unit().GetIntegrity() > 0 && fighterProto.GetIsConfrontational() {
-			// Target is still alive and fighter is confrontational, do not move on to the movement phase
+		if targetUnit.Unit().GetIntegrity() > 0 && engages {
+			// Target is still alive and fighter engages, do not move on to the movement phase
 			return false
 		} else if fighterProto.GetIsAssault() {
 			// Assault units can move and attack in the same tick so they move on to the movement phase
@@ -1567,7 +2126,10 @@ func (c *Core) tickFighterMovement(obj UnitObjectWithRow) bool {
 	)
 	var targetArea image.Rectangle
 
-	if fighterCommand.Type().IsTargetingPosition() {
+	if fighterCommand.Type() == FighterCommandType_Patrol {
+		// The patrol target is one more waypoint of the loop
+		targetArea = waypointArea(fighterCommand.TargetPosition())
+	} else if fighterCommand.Type().IsTargetingPosition() {
 		targetPosition := fighterCommand.TargetPosition()
 		targetSize := image.Point{1, 1}
 		targetArea = image.Rectangle{Min: targetPosition, Max: targetPosition.Add(targetSize)}
@@ -1581,8 +2143,19 @@ func (c *Core) tickFighterMovement(obj UnitObjectWithRow) bool {
 	}
 
 	if fighterPosition.In(targetArea) {
-		// Already in target area, move on to the next phase (do nothing)
-		return true
+		if fighterCommand.Type() != FighterCommandType_Patrol {
+			// Already in target area, move on to the next phase (do nothing)
+			return true
+		}
+		// Patrol target reached, restart the waypoint loop
+		path := NewCommandPath(fighter.GetCommandExtra(), fighter.GetCommandMeta())
+		path.ResetPointer()
+		fighter.SetCommandMeta(path.Meta().Uint8())
+		firstWaypoint := path.CurrentPoint()
+		targetArea = image.Rectangle{Min: firstWaypoint, Max: firstWaypoint.Add(image.Point{1, 1})}
+		if fighterPosition.In(targetArea) {
+			return true
+		}
 	}
 
 	if fighterCommand.Type().IsTargetingBuilding() || fighterCommand.Type().IsTargetingUnit() {
@@ -1633,17 +2206,37 @@ func (c *Core) tickUnit(obj UnitObjectWithRow) {
 }
 
 func (c *Core) Tick() {
+	defer c.enableRowCache()()
+	c.tick()
+}
+
+func (c *Core) tick() {
 	if !c.IsInitialized() {
 		return
 	}
 	if !c.HasStarted() {
 		return
 	}
+	if c.IsGameOver() {
+		return
+	}
+	if c.IsPaused() {
+		// Count the tick as paused so AbsSubTickIndex stays put until the match is resumed
+		meta := c.GetMeta()
+		meta.SetPausedTicks(meta.GetPausedTicks() + 1)
+		meta.SetCurrentPauseTicks(meta.GetCurrentPauseTicks() + 1)
+		if meta.GetCurrentPauseTicks() >= MaxPauseTicks {
+			c.resume()
+		}
+		return
+	}
 
 	nPlayers := c.GetMeta().GetPlayerCount()
 	if nPlayers == 0 {
 		return
 	}
+	defer c.enableUnitIndex()()
+
 	for playerId := uint8(1); playerId < nPlayers+1; playerId++ {
 		c.tickPlayer(playerId)
 	}
@@ -1666,7 +2259,8 @@ func (c *Core) Tick() {
 		playerIdx := (startingPlayerIdx + ii) % nPlayers
 		playerId := playerIdx + 1
 		nUnits := c.GetPlayer(playerId).GetUnitCount()
-		for unitId := uint8(1); unitId < nUnits+1; unitId++ {
+		for i := 1; i <= int(nUnits); i++ {
+			unitId := uint8(i)
 			if ok := c.tickUnitPreliminary(c.GetUnitObject(playerId, unitId)); ok {
 				prePassers[playerIdx] = append(prePassers[playerIdx], unitId)
 			}
@@ -1712,7 +2306,8 @@ func (c *Core) Tick() {
 		playerIdx := (startingPlayerIdx + ii) % nPlayers
 		playerId := playerIdx + 1
 		nUnits := c.GetPlayer(playerId).GetUnitCount()
-		for unitId := uint8(1); unitId < nUnits+1; unitId++ {
+		for i := 1; i <= int(nUnits); i++ {
+			unitId := uint8(i)
 			if ok := c.tickUnitIntermediate(c.GetUnitObject(playerId, unitId)); ok {
 				interPassers[playerIdx] = append(interPassers[playerIdx], unitId)
 			}
@@ -1754,6 +2349,8 @@ func (c *Core) Tick() {
 			c.tickWorkerMovement(c.GetUnitObject(playerId, unitId))
 		}
 	}
+
+	c.updateGameOver()
 }
 
 func (c *Core) purgePlayer(playerId uint8) {
@@ -1763,8 +2360,9 @@ func (c *Core) purgePlayer(playerId uint8) {
 		nUnpurgeableUnits = player.GetUnpurgeableUnitCount()
 		startingUnitId    = nUnpurgeableUnits + 1
 	)
-	for unitId := startingUnitId; unitId < nUnits+1; unitId++ {
+	for i := int(startingUnitId); i <= int(nUnits); i++ {
 		var (
+			unitId  = uint8(i)
 			unitObj = c.GetUnitObject(playerId, unitId)
 			unit    = unitObj.Unit()
 			protoId = unit.GetUnitType()
@@ -1788,6 +2386,12 @@ func (c *Core) Purge() {
 	if !c.HasStarted() {
 		return
 	}
+	if c.IsGameOver() {
+		return
+	}
+	if c.IsPaused() {
+		return
+	}
 	nPlayers := c.GetMeta().GetPlayerCount()
 	for playerId := uint8(1); playerId < nPlayers+1; playerId++ {
 		c.purgePlayer(playerId)
@@ -1802,10 +2406,101 @@ func (c *Core) HasStarted() bool {
 	return c.GetMeta().GetHasStarted()
 }
 
+func (c *Core) IsGameOver() bool {
+	return c.GetMeta().GetIsGameOver()
+}
+
+func (c *Core) IsPaused() bool {
+	return c.GetMeta().GetIsPaused()
+}
+
+func (c *Core) creationTick() uint32 {
+	return uint32(uint64(c.GetMeta().GetCreationBlockNumber()) * c.TicksPerBlock())
+}
+
+// Returns the number of ticks elapsed since the match was created.
+func (c *Core) ElapsedTicks() uint32 {
+	return c.AbsSubTickIndex() - c.creationTick()
+}
+
+// Returns true if the match has a tick limit and it has been exceeded.
+func (c *Core) IsOutOfTime() bool {
+	maxTicks := c.GetMeta().GetMaxTicks()
+	return maxTicks != 0 && c.ElapsedTicks() > maxTicks
+}
+
+// Returns true if the match is over because it ran out of time. It is decided from the end tick,
+// so unlike IsOutOfTime it does not change as blocks keep coming after the match is over.
+func (c *Core) EndedOutOfTime() bool {
+	meta := c.GetMeta()
+	if !meta.GetIsGameOver() || meta.GetWinnerId() != NilPlayerId {
+		return false
+	}
+	maxTicks := meta.GetMaxTicks()
+	return maxTicks != 0 && meta.GetEndTick()-c.creationTick() > maxTicks
+}
+
+// Ends the match when the main buildings of a single team (or none) are left standing, or when the
+// match runs out of time. The winner id is a player of the winning team, or NilPlayerId on a draw.
+func (c *Core) updateGameOver() {
+	nPlayers := c.GetMeta().GetPlayerCount()
+	if nPlayers > 1 {
+		activePlayers := make([]uint8, 0, nPlayers)
+		for playerId := uint8(1); playerId < nPlayers+1; playerId++ {
+			player := c.GetPlayer(playerId)
+			if BuildingState(c.GetMainBuilding(playerId).GetState()) == BuildingState_Destroyed {
+				c.setPlayerEliminated(playerId)
+			}
+			if !player.GetIsEliminated() {
+				activePlayers = append(activePlayers, playerId)
+			}
+		}
+		if len(activePlayers) == 0 {
+			c.setGameOver(NilPlayerId)
+			return
+		}
+		singleTeamLeft := true
+		for _, playerId := range activePlayers {
+			if !c.AreAllies(activePlayers[0], playerId) {
+				singleTeamLeft = false
+				break
+			}
+		}
+		if singleTeamLeft {
+			c.setGameOver(activePlayers[0])
+			return
+		}
+	}
+	if c.IsOutOfTime() {
+		c.setGameOver(NilPlayerId)
+	}
+}
+
+func (c *Core) setPlayerEliminated(playerId uint8) {
+	player := c.GetPlayer(playerId)
+	if player.GetIsEliminated() {
+		return
+	}
+	player.SetIsEliminated(true)
+	c.emitInternalEvent(&InternalEvent_PlayerEliminated{
+		PlayerId: playerId,
+	})
+}
+
+func (c *Core) setGameOver(winnerId uint8) {
+	meta := c.GetMeta()
+	meta.SetIsGameOver(true)
+	meta.SetWinnerId(winnerId)
+	meta.SetEndTick(c.AbsSubTickIndex())
+}
+
 func (c *Core) Initialize(action *Initialization) error {
 	if c.IsInitialized() {
 		return ErrAlreadyInitialized
 	}
+	if action.DemolitionRefundPercent > 100 {
+		return ErrInvalidRefundPercent
+	}
 	bn := c.BlockNumber()
 	meta := c.GetMeta()
 	meta.SetBoardWidth(action.Width)
@@ -1813,6 +2508,18 @@ func (c *Core) Initialize(action *Initialization) error {
 	meta.SetIsInitialized(true)
 	meta.SetHasStarted(false)
 	meta.SetCreationBlockNumber(uint32(bn))
+	meta.SetMaxTicks(action.MaxTicks)
+	if action.TicksPerBlock == 0 {
+		meta.SetTicksPerBlock(DefaultTicksPerBlock)
+	} else {
+		meta.SetTicksPerBlock(action.TicksPerBlock)
+	}
+	meta.SetDemolitionRefundPercent(action.DemolitionRefundPercent)
+	if action.PathFindNodeBudget == 0 {
+		meta.SetPathFindNodeBudget(DefaultPathFindNodeBudget)
+	} else {
+		meta.SetPathFindNodeBudget(action.PathFindNodeBudget)
+	}
 	return nil
 }
 
@@ -1834,6 +2541,7 @@ func (c *Core) AddPlayer(action *PlayerAddition) error {
 	player.SetWorkerPortX(action.WorkerPortX)
 	player.SetWorkerPortY(action.WorkerPortY)
 	player.SetUnpurgeableUnitCount(action.UnpurgeableUnitCount)
+	player.SetTeamId(action.TeamId)
 	player.SetBuildingPayQueuePointer(1)
 	player.SetBuildingBuildQueuePointer(1)
 	player.SetUnitPayQueuePointer(1)
@@ -1854,6 +2562,12 @@ func (c *Core) CreateUnit(action *UnitCreation) error {
 	if !c.IsInitialized() {
 		return ErrNotInitialized
 	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	if c.IsPaused() {
+		return ErrPaused
+	}
 	var (
 		playerId = action.PlayerId
 		protoId  = action.UnitType
@@ -1887,21 +2601,57 @@ func (c *Core) AssignUnit(action *UnitAssignation) error {
 	if !c.IsInitialized() {
 		return ErrNotInitialized
 	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	if c.IsPaused() {
+		return ErrPaused
+	}
+	assignment, err := c.validateUnitAssignation(action)
+	if err != nil {
+		return err
+	}
+	assignment.apply(c)
+	return nil
+}
+
+// A validated unit assignation ready to be applied.
+type unitAssignment struct {
+	obj     UnitObjectWithRow
+	command UnitCommandData
+	path    *CommandPath
+}
+
+func (a unitAssignment) apply(c *Core) {
+	c.assignUnitExternal(a.obj, a.command, a.path)
+}
+
+// Checks that the unit exists and can take the command without modifying any state.
+func (c *Core) validateUnitAssignation(action *UnitAssignation) (unitAssignment, error) {
 	var (
 		playerId = action.PlayerId
 		unitId   = action.UnitId
-		unit     = c.GetUnit(playerId, unitId)
-		protoId  = unit.GetUnitType()
-		proto    = c.GetUnitPrototype(protoId)
 	)
 	if err := c.ValidatePlayerId(playerId); err != nil {
-		return err
+		return unitAssignment{}, err
+	}
+	if err := c.ValidateUnitId(playerId, unitId); err != nil {
+		return unitAssignment{}, err
 	}
+	var (
+		unit    = c.GetUnit(playerId, unitId)
+		protoId = unit.GetUnitType()
+		proto   = c.GetUnitPrototype(protoId)
+		obj     = c.GetUnitObject(playerId, unitId)
+	)
 	if c.GetMainBuilding(playerId).GetIntegrity() == 0 {
-		return ErrMainBuildingDestroyed
+		return unitAssignment{}, ErrMainBuildingDestroyed
+	}
+	if UnitState(unit.GetState()).IsNil() {
+		return unitAssignment{}, ErrInvalidUnitId
 	}
 	if UnitState(unit.GetState()) == UnitState_Dead {
-		return ErrUnitDead
+		return unitAssignment{}, ErrUnitDead
 	}
 	if proto.GetIsWorker() {
 		command := WorkerCommandData(action.Command)
@@ -1921,20 +2671,37 @@ func (c *Core) AssignUnit(action *UnitAssignation) error {
 					targetProto   = c.GetBuildingPrototype(targetProtoId)
 				)
 				if !targetProto.GetIsEnvironment() {
-					return errors.New("target must be environment")
+					return unitAssignment{}, errors.New("target must be environment")
+				}
+				if BuildingState(targetBuilding.GetState()) == BuildingState_Depleted {
+					return unitAssignment{}, errors.New("target must not be depleted")
 				}
 			} else if commandType == WorkerCommandType_Build {
 				if targetPlayerId != playerId {
-					return errors.New("target must be self")
+					return unitAssignment{}, errors.New("target must be self")
 				}
 				if BuildingState(targetBuilding.GetState()) != BuildingState_Building {
-					return errors.New("target must be in building state")
+					return unitAssignment{}, errors.New("target must be in building state")
+				}
+			} else if commandType == WorkerCommandType_Repair {
+				if proto.GetRepairIntegrity() == 0 {
+					return unitAssignment{}, errors.New("unit cannot repair")
+				}
+				if targetPlayerId != playerId {
+					return unitAssignment{}, errors.New("target must be self")
+				}
+				if BuildingState(targetBuilding.GetState()) != BuildingState_Built {
+					return unitAssignment{}, errors.New("target must be built")
+				}
+				targetProto := c.GetBuildingPrototype(targetBuilding.GetBuildingType())
+				if targetBuilding.GetIntegrity() >= targetProto.GetMaxIntegrity() {
+					return unitAssignment{}, errors.New("target must be damaged")
 				}
 			} else {
-				return errors.New("command not assignable")
+				return unitAssignment{}, errors.New("command not assignable")
 			}
 		}
-		c.assignUnitExternal(c.GetUnitObject(playerId, unitId), command, &CommandPath{})
+		return unitAssignment{obj, command, &CommandPath{}}, nil
 	} else {
 		command := FighterCommandData(action.Command)
 		commandType := command.Type()
@@ -1942,62 +2709,124 @@ func (c *Core) AssignUnit(action *UnitAssignation) error {
 			targetPlayerId := command.TargetPlayerId()
 			targetBuildingId := command.TargetBuildingId()
 			if err := c.ValidatePlayerId(targetPlayerId); err != nil {
-				return err
+				return unitAssignment{}, err
 			}
 			if err := c.ValidateBuildingId(targetPlayerId, targetBuildingId); err != nil {
-				return err
+				return unitAssignment{}, err
 			}
-			if targetPlayerId == playerId {
-				return errors.New("target must be an enemy")
+			if c.AreAllies(playerId, targetPlayerId) {
+				return unitAssignment{}, errors.New("target must be an enemy")
 			}
 			targetBuilding := c.GetBuilding(targetPlayerId, targetBuildingId)
 			targetProtoId := targetBuilding.GetBuildingType()
 			targetProto := c.GetBuildingPrototype(targetProtoId)
 			if targetProto.GetIsEnvironment() {
-				return errors.New("target must be player-owned")
+				return unitAssignment{}, errors.New("target must be player-owned")
 			}
 			targetBuildingState := BuildingState(targetBuilding.GetState())
 			if targetBuildingState == BuildingState_Destroyed {
-				return errors.New("target must not be destroyed")
+				return unitAssignment{}, errors.New("target must not be destroyed")
 			}
 			if targetBuildingState == BuildingState_Unpaid {
-				return errors.New("target must not be unpaid")
+				return unitAssignment{}, errors.New("target must not be unpaid")
 			}
 		} else if commandType.IsTargetingUnit() {
 			targetPlayerId := command.TargetPlayerId()
 			targetUnitId := command.TargetUnitId()
 			if err := c.ValidatePlayerId(targetPlayerId); err != nil {
-				return err
+				return unitAssignment{}, err
 			}
 			if err := c.ValidateUnitId(targetPlayerId, targetUnitId); err != nil {
-				return err
+				return unitAssignment{}, err
 			}
-			if targetPlayerId == playerId {
-				return errors.New("target must be an enemy")
+			if c.AreAllies(playerId, targetPlayerId) {
+				return unitAssignment{}, errors.New("target must be an enemy")
 			}
 			targetUnit := c.GetUnit(targetPlayerId, targetUnitId)
 			targetProtoId := targetUnit.GetUnitType()
 			targetProto := c.GetUnitPrototype(targetProtoId)
 			if targetProto.GetIsWorker() {
-				return errors.New("target must not be a worker")
+				return unitAssignment{}, errors.New("target must not be a worker")
 			}
 			targetUnitState := UnitState(targetUnit.GetState())
 			if targetUnitState == UnitState_Dead {
-				return errors.New("target must not be dead")
+				return unitAssignment{}, errors.New("target must not be dead")
 			}
 			if targetUnitState == UnitState_Unpaid {
-				return errors.New("target must not be unpaid")
+				return unitAssignment{}, errors.New("target must not be unpaid")
 			}
 		} else if commandType.IsTargetingPosition() {
 			targetPosition := command.TargetPosition()
 			if !c.TileIsInBoard(targetPosition) {
-				return errors.New("target must be in board")
+				return unitAssignment{}, errors.New("target must be in board")
+			}
+			if commandType == FighterCommandType_Patrol && !NewCommandPath(action.CommandExtra, action.CommandMeta).HasPath() {
+				return unitAssignment{}, errors.New("patrol must have at least one waypoint")
 			}
 		} else {
-			return errors.New("command not assignable")
+			return unitAssignment{}, errors.New("command not assignable")
 		}
 		path := NewCommandPath(action.CommandExtra, action.CommandMeta)
-		c.assignUnitExternal(c.GetUnitObject(playerId, unitId), command, path)
+		return unitAssignment{obj, command, path}, nil
+	}
+}
+
+// Creates an AssignUnits action assigning the same command to all the given units.
+func NewUnitsAssignation(playerId uint8, unitIds []uint8, command uint64, commandExtra uint64, commandMeta uint8) *UnitsAssignation {
+	mask := NewUnitMask(unitIds...)
+	return &UnitsAssignation{
+		PlayerId:     playerId,
+		UnitMask0:    mask[0],
+		UnitMask1:    mask[1],
+		UnitMask2:    mask[2],
+		UnitMask3:    mask[3],
+		Command:      command,
+		CommandExtra: commandExtra,
+		CommandMeta:  commandMeta,
+	}
+}
+
+// Returns the set of units targeted by an AssignUnits action.
+func GetUnitMask(action *UnitsAssignation) UnitMask {
+	return UnitMask{action.UnitMask0, action.UnitMask1, action.UnitMask2, action.UnitMask3}
+}
+
+// AssignUnits assigns the same command to every unit in the unit mask. Each unit is validated as
+// in AssignUnit and any error aborts the action before any unit is assigned.
+func (c *Core) AssignUnits(action *UnitsAssignation) error {
+	if !c.IsInitialized() {
+		return ErrNotInitialized
+	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	if c.IsPaused() {
+		return ErrPaused
+	}
+	mask := GetUnitMask(action)
+	if mask.Has(NilUnitId) {
+		return ErrInvalidUnitId
+	}
+	unitIds := mask.UnitIds()
+	if len(unitIds) == 0 {
+		return ErrEmptyUnitMask
+	}
+	assignments := make([]unitAssignment, 0, len(unitIds))
+	for _, unitId := range unitIds {
+		assignment, err := c.validateUnitAssignation(&UnitAssignation{
+			PlayerId:     action.PlayerId,
+			UnitId:       unitId,
+			Command:      action.Command,
+			CommandExtra: action.CommandExtra,
+			CommandMeta:  action.CommandMeta,
+		})
+		if err != nil {
+			return err
+		}
+		assignments = append(assignments, assignment)
+	}
+	for _, assignment := range assignments {
+		assignment.apply(c)
 	}
 	return nil
 }
@@ -2006,6 +2835,12 @@ func (c *Core) PlaceBuilding(action *BuildingPlacement) error {
 	if !c.IsInitialized() {
 		return ErrNotInitialized
 	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	if c.IsPaused() {
+		return ErrPaused
+	}
 	var (
 		playerId = action.PlayerId
 		protoId  = action.BuildingType
@@ -2051,6 +2886,181 @@ func (c *Core) PlaceBuilding(action *BuildingPlacement) error {
 	return nil
 }
 
+// Cancels an unpaid or spawning unit, freeing its spawn tile. Spawning units are refunded.
+func (c *Core) CancelUnit(action *UnitCancellation) error {
+	if !c.IsInitialized() {
+		return ErrNotInitialized
+	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	if c.IsPaused() {
+		return ErrPaused
+	}
+	var (
+		playerId = action.PlayerId
+		unitId   = action.UnitId
+	)
+	if err := c.ValidatePlayerId(playerId); err != nil {
+		return err
+	}
+	if err := c.ValidateUnitId(playerId, unitId); err != nil {
+		return err
+	}
+	obj := c.GetUnitObject(playerId, unitId)
+	if state := UnitState(obj.Unit().GetState()); state != UnitState_Unpaid && state != UnitState_Spawning {
+		return ErrUnitNotCancellable
+	}
+	c.setUnitCancelled(obj)
+	c.skipSettledUnitsInPayQueue(playerId)
+	return nil
+}
+
+// Removes a built building of the player other than their main building, refunding the demolition
+// refund percent of its resource cost.
+func (c *Core) DemolishBuilding(action *BuildingDemolition) error {
+	if !c.HasStarted() {
+		return ErrNotStarted
+	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	if c.IsPaused() {
+		return ErrPaused
+	}
+	var (
+		playerId   = action.PlayerId
+		buildingId = action.BuildingId
+	)
+	if err := c.ValidatePlayerId(playerId); err != nil {
+		return err
+	}
+	if err := c.ValidateBuildingId(playerId, buildingId); err != nil {
+		return err
+	}
+	if buildingId == MainBuildingId {
+		return ErrBuildingNotDemolishable
+	}
+	obj := c.GetBuildingObject(playerId, buildingId)
+	if BuildingState(obj.Building().GetState()) != BuildingState_Built {
+		return ErrBuildingNotDemolishable
+	}
+	c.setBuildingDemolished(obj)
+	return nil
+}
+
+// Destroys the player's main building, kills their units and eliminates them from the match.
+// Players can surrender while the match is paused, which resumes it.
+func (c *Core) Surrender(action *Surrender) error {
+	if !c.HasStarted() {
+		return ErrNotStarted
+	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	playerId := action.PlayerId
+	if err := c.ValidatePlayerId(playerId); err != nil {
+		return err
+	}
+	player := c.GetPlayer(playerId)
+	if player.GetIsEliminated() {
+		return ErrPlayerEliminated
+	}
+	if c.IsPaused() {
+		c.resume()
+	}
+	mainBuilding := c.GetBuildingObject(playerId, MainBuildingId)
+	if BuildingState(mainBuilding.Building().GetState()) != BuildingState_Destroyed {
+		mainBuilding.Building().SetIntegrity(0)
+		c.setBuildingDestroyed(mainBuilding)
+	}
+	c.ForEachUnit(playerId, func(unitId uint8, unit *datamod.UnitsRow) {
+		if state := UnitState(unit.GetState()); state.IsNil() || state == UnitState_Dead {
+			return
+		}
+		unit.SetIntegrity(0)
+		c.setUnitDead(c.GetUnitObject(playerId, unitId))
+	})
+	c.setPlayerEliminated(playerId)
+	return nil
+}
+
+// Registers the player's request to pause the match. The match is paused once every player still in
+// the match has requested it. The first player to request the pause is recorded as the one who
+// paused the match.
+func (c *Core) RequestPause(action *PauseRequest) error {
+	if !c.HasStarted() {
+		return ErrNotStarted
+	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	playerId := action.PlayerId
+	if err := c.ValidatePlayerId(playerId); err != nil {
+		return err
+	}
+	if c.GetPlayer(playerId).GetIsEliminated() {
+		return ErrPlayerEliminated
+	}
+	if c.IsPaused() {
+		return ErrPaused
+	}
+	meta := c.GetMeta()
+	if pausedBy := meta.GetPausedBy(); pausedBy == NilPlayerId || c.GetPlayer(pausedBy).GetIsEliminated() {
+		// An eliminated player cannot resume, so the pause passes to the next player to request it
+		meta.SetPausedBy(playerId)
+	}
+	c.GetPlayer(playerId).SetIsPauseRequested(true)
+	allRequested := true
+	c.ForEachPlayer(func(playerId uint8, player *datamod.PlayersRow) {
+		if !player.GetIsEliminated() && !player.GetIsPauseRequested() {
+			allRequested = false
+		}
+	})
+	if allRequested {
+		meta.SetIsPaused(true)
+	}
+	return nil
+}
+
+// Resumes the match, or withdraws the player's pause request if the match is not paused yet.
+// Only the player who paused the match can resume it; otherwise the match resumes on its own after
+// MaxPauseTicks. Pause requests are cleared so a new pause needs the agreement of every player again.
+func (c *Core) Resume(action *Resumption) error {
+	if !c.HasStarted() {
+		return ErrNotStarted
+	}
+	if c.IsGameOver() {
+		return ErrGameOver
+	}
+	playerId := action.PlayerId
+	if err := c.ValidatePlayerId(playerId); err != nil {
+		return err
+	}
+	if c.GetPlayer(playerId).GetIsEliminated() {
+		return ErrPlayerEliminated
+	}
+	if !c.IsPaused() && !c.GetPlayer(playerId).GetIsPauseRequested() {
+		return ErrNotPaused
+	}
+	meta := c.GetMeta()
+	if c.IsPaused() && playerId != meta.GetPausedBy() {
+		return ErrPausedByOtherPlayer
+	}
+	c.resume()
+	return nil
+}
+
+func (c *Core) resume() {
+	c.ForEachPlayer(func(playerId uint8, player *datamod.PlayersRow) {
+		player.SetIsPauseRequested(false)
+	})
+	meta := c.GetMeta()
+	meta.SetIsPaused(false)
+	meta.SetPausedBy(NilPlayerId)
+	meta.SetCurrentPauseTicks(0)
+}
+
 func (c *Core) AddUnitPrototype(action *UnitPrototypeAddition) error {
 	// if !c.IsInitialized() {
 	// 	return ErrNotInitialized
@@ -2073,6 +3083,12 @@ func (c *Core) AddUnitPrototype(action *UnitPrototypeAddition) error {
 		action.AirStrength,
 		action.AttackRange,
 		action.AttackCooldown,
+		action.VisionRadius,
+		action.SplashRadius,
+		action.SplashFalloff,
+		action.RepairCooldown,
+		action.RepairIntegrity,
+		action.RepairResourceCost,
 		action.IsAssault,
 		action.IsConfrontational,
 		action.IsWorker,
@@ -2102,8 +3118,69 @@ func (c *Core) AddBuildingPrototype(action *BuildingPrototypeAddition) error {
 		action.MineTime,
 		action.MaxIntegrity,
 		action.BuildingTime,
+		action.VisionRadius,
 		action.IsArmory,
 		action.IsEnvironment,
+		action.ResourceReserve,
 	)
 	return nil
 }
+
+// Overrides the damage units of the attacker prototype deal to units of the target prototype before
+// the match starts.
+func (c *Core) SetDamage(action *DamageSetting) error {
+	if c.HasStarted() {
+		return ErrAlreadyStarted
+	}
+	nUnitPrototypes := c.GetMeta().GetUnitPrototypeCount()
+	if action.AttackerType == 0 || action.AttackerType > nUnitPrototypes {
+		return ErrInvalidUnitType
+	}
+	if action.TargetType == 0 || action.TargetType > nUnitPrototypes {
+		return ErrInvalidUnitType
+	}
+	entry := c.GetDamageMatrixEntry(action.AttackerType, action.TargetType)
+	entry.Set(action.Strength, true)
+	return nil
+}
+
+// Overrides the resources left in an environment mine placed before the match starts. A reserve of
+// zero leaves the mine unlimited.
+func (c *Core) SetMineReserve(action *MineReserveSetting) error {
+	if c.HasStarted() {
+		return ErrAlreadyStarted
+	}
+	if err := c.ValidateBuildingId(NilPlayerId, action.BuildingId); err != nil {
+		return err
+	}
+	building := c.GetBuilding(NilPlayerId, action.BuildingId)
+	if c.GetBuildingPrototype(building.GetBuildingType()).GetResourceMine() == 0 {
+		return ErrNotResourceMine
+	}
+	building.SetResourceReserve(action.ResourceReserve)
+	return nil
+}
+
+// Sets the terrain of an empty tile before the match starts.
+func (c *Core) SetTerrain(action *TerrainSetting) error {
+	if !c.IsInitialized() {
+		return ErrNotInitialized
+	}
+	if c.HasStarted() {
+		return ErrAlreadyStarted
+	}
+	position := image.Point{int(action.X), int(action.Y)}
+	if !c.TileIsInBoard(position) {
+		return ErrInvalidPosition
+	}
+	if TerrainType(action.Terrain) >= TerrainType_Count {
+		return ErrInvalidTerrainType
+	}
+	tile := c.GetBoardTile(action.X, action.Y)
+	if !IsTileEmptyAllLayers(tile) {
+		// Objects are placed after the terrain so they can be checked against it
+		return ErrTileOccupied
+	}
+	tile.SetTerrain(action.Terrain)
+	return nil
+}
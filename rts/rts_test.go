@@ -0,0 +1,900 @@
+package rts
+
+import (
+	"image"
+	"testing"
+
+	"github.com/concrete-eth/archetype/arch"
+	"github.com/concrete-eth/archetype/kvstore"
+)
+
+const (
+	testProtoId_Fighter uint8 = iota + 1
+	testProtoId_Dummy
+	testProtoId_Worker
+)
+
+// newTestMatch creates a started two player match on a 12x3 board. Player 1 has its main building
+// at (0, 0) and spawns in x = [1, 4). Player 2 has its main building at (11, 0) and spawns in
+// x = [4, 11). Fighters deal 5 damage at range 1 and dummies have 10 integrity and do not attack.
+// Main buildings have 100 integrity and players start with 1000 resources.
+func newTestMatch(t *testing.T) *Core {
+	c := newTestCore(t, 12, 3)
+	mustNotFail(t, c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 1, Height: 1, MaxIntegrity: 100, ResourceCapacity: 1000, ComputeCapacity: 16, VisionRadius: 2,
+	}))
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), SpawnTime: 1, MaxIntegrity: 20, LandStrength: 5, AttackRange: 1, AttackCooldown: 1, VisionRadius: 2,
+	}))
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), SpawnTime: 1, MaxIntegrity: 10, VisionRadius: 2,
+	}))
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Hover), SpawnTime: 1, MaxIntegrity: 10, VisionRadius: 2,
+		RepairCooldown: 2, RepairIntegrity: 5, RepairResourceCost: 5, IsWorker: true,
+	}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 1, SpawnAreaWidth: 3, SpawnAreaHeight: 3}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 4, SpawnAreaWidth: 7, SpawnAreaHeight: 3}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: 1, X: 0, Y: 0}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 2, BuildingType: 1, X: 11, Y: 0}))
+	return c
+}
+
+// startTestMatch starts the match and runs the given number of blocks.
+func startTestMatch(t *testing.T, c *Core, blocks int) {
+	mustNotFail(t, c.Start(&Start{}))
+	runTestBlocks(c, blocks)
+}
+
+func runTestBlocks(c *Core, blocks int) {
+	for i := 0; i < blocks; i++ {
+		c.SetBlockNumber(c.BlockNumber() + 1)
+		arch.RunBlockTicks(c)
+	}
+}
+
+func mustNotFail(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAreAllies(t *testing.T) {
+	c := newTestCore(t, 16, 16)
+	for _, teamId := range []uint8{1, 1, 2, NilTeamId, NilTeamId} {
+		if err := c.AddPlayer(&PlayerAddition{SpawnAreaWidth: 1, SpawnAreaHeight: 1, TeamId: teamId}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		a, b   uint8
+		allies bool
+	}{
+		{1, 1, true},
+		{1, 2, true},
+		{1, 3, false},
+		{2, 3, false},
+		{4, 4, true},
+		{4, 5, false}, // Players without a team are only allied with themselves
+		{3, 4, false},
+	}
+	for _, tt := range tests {
+		if allies := c.AreAllies(tt.a, tt.b); allies != tt.allies {
+			t.Errorf("AreAllies(%d, %d): expected %v, got %v", tt.a, tt.b, tt.allies, allies)
+		}
+	}
+}
+
+func TestAttackMove(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 1, Y: 1}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: testProtoId_Dummy, X: 5, Y: 1}))
+
+	command := NewFighterCommandData(FighterCommandType_AttackMove)
+	command.SetTargetPosition(image.Point{9, 1})
+	mustNotFail(t, c.AssignUnit(&UnitAssignation{PlayerId: 1, UnitId: 1, Command: command.Uint64()}))
+
+	startTestMatch(t, c, 32)
+
+	if state := UnitState(c.GetUnit(2, 1).GetState()); state != UnitState_Dead {
+		t.Errorf("expected enemy to be dead, got state %v", state)
+	}
+	fighter := c.GetUnit(1, 1)
+	if position := GetPositionAsPoint(fighter); position != (image.Point{9, 1}) {
+		t.Errorf("expected fighter to resume moving to %v, got %v", image.Point{9, 1}, position)
+	}
+	if commandType := FighterCommandData(fighter.GetCommand()).Type(); commandType != FighterCommandType_AttackMove {
+		t.Errorf("expected command type %v, got %v", FighterCommandType_AttackMove, commandType)
+	}
+}
+
+func TestAssignUnitsAtomic(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 1, Y: 1}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 2, Y: 1}))
+	startTestMatch(t, c, 1)
+
+	commands := []uint64{c.GetUnit(1, 1).GetCommand(), c.GetUnit(1, 2).GetCommand()}
+	command := NewFighterCommandData(FighterCommandType_AttackMove)
+	command.SetTargetPosition(image.Point{9, 1})
+	err := c.AssignUnits(NewUnitsAssignation(1, []uint8{1, 2, 5}, command.Uint64(), 0, 0))
+	if err != ErrInvalidUnitId {
+		t.Fatalf("expected %v, got %v", ErrInvalidUnitId, err)
+	}
+	for i, unitId := range []uint8{1, 2} {
+		unit := c.GetUnit(1, unitId)
+		if unit.GetCommand() != commands[i] || unit.GetIsPreTicked() {
+			t.Errorf("expected unit %d to be unchanged after a rejected batch", unitId)
+		}
+	}
+
+	mustNotFail(t, c.AssignUnits(NewUnitsAssignation(1, []uint8{1, 2}, command.Uint64(), 0, 0)))
+	for _, unitId := range []uint8{1, 2} {
+		if c.GetUnit(1, unitId).GetCommand() != command.Uint64() {
+			t.Errorf("expected unit %d to be assigned the command", unitId)
+		}
+	}
+}
+
+func TestPatrol(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 1, Y: 1}))
+
+	command := NewFighterCommandData(FighterCommandType_Patrol)
+	command.SetTargetPosition(image.Point{8, 1})
+	path := &CommandPath{}
+	path.SetPath([]image.Point{{2, 1}})
+	mustNotFail(t, c.AssignUnit(&UnitAssignation{
+		PlayerId:     1,
+		UnitId:       1,
+		Command:      command.Uint64(),
+		CommandExtra: path.RawPath(),
+		CommandMeta:  path.Meta().Uint8(),
+	}))
+
+	startTestMatch(t, c, 0)
+	laps := 0
+	atTarget := false
+	for i := 0; i < 64; i++ {
+		runTestBlocks(c, 1)
+		x := GetPositionAsPoint(c.GetUnit(1, 1)).X
+		if x >= 7 {
+			// The patrol target is reached within one tile like the waypoints
+			atTarget = true
+		} else if x <= 3 && atTarget {
+			atTarget = false
+			laps++
+		}
+	}
+	if laps < 2 {
+		t.Errorf("expected at least 2 patrol laps, got %d", laps)
+	}
+
+	// Patrols without waypoints are rejected
+	err := c.AssignUnit(&UnitAssignation{PlayerId: 1, UnitId: 1, Command: command.Uint64()})
+	if err == nil {
+		t.Errorf("expected error assigning patrol without waypoints")
+	}
+}
+
+func TestPatrolSharedRoute(t *testing.T) {
+	c := newTestMatch(t)
+	command := NewFighterCommandData(FighterCommandType_Patrol)
+	command.SetTargetPosition(image.Point{8, 1})
+	path := &CommandPath{}
+	path.SetPath([]image.Point{{2, 1}})
+	for unitId, y := range []uint16{0, 2} {
+		mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 1, Y: y}))
+		mustNotFail(t, c.AssignUnit(&UnitAssignation{
+			PlayerId:     1,
+			UnitId:       uint8(unitId + 1),
+			Command:      command.Uint64(),
+			CommandExtra: path.RawPath(),
+			CommandMeta:  path.Meta().Uint8(),
+		}))
+	}
+
+	// A unit holding the patrol target keeps the tile taken, both patrollers must keep looping
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 3, Y: 1}))
+	hold := NewFighterCommandData(FighterCommandType_HoldPosition)
+	hold.SetTargetPosition(image.Point{8, 1})
+	mustNotFail(t, c.AssignUnit(&UnitAssignation{PlayerId: 1, UnitId: 3, Command: hold.Uint64()}))
+	startTestMatch(t, c, 0)
+	laps := [2]int{}
+	atTarget := [2]bool{}
+	for i := 0; i < 64; i++ {
+		runTestBlocks(c, 1)
+		for unitId := range laps {
+			x := GetPositionAsPoint(c.GetUnit(1, uint8(unitId+1))).X
+			if x >= 7 {
+				atTarget[unitId] = true
+			} else if x <= 3 && atTarget[unitId] {
+				atTarget[unitId] = false
+				laps[unitId]++
+			}
+		}
+	}
+	if position := GetPositionAsPoint(c.GetUnit(1, 3)); !position.Eq(image.Point{8, 1}) {
+		t.Fatalf("expected unit 3 to hold the patrol target, got %v", position)
+	}
+	for unitId, unitLaps := range laps {
+		if unitLaps < 2 {
+			t.Errorf("expected unit %d to do at least 2 patrol laps, got %d", unitId+1, unitLaps)
+		}
+	}
+}
+
+func TestRepair(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Worker, X: 1, Y: 1}))
+
+	command := NewWorkerCommandData(WorkerCommandType_Repair)
+	command.SetTargetBuilding(1, 1)
+	action := &UnitAssignation{PlayerId: 1, UnitId: 1, Command: command.Uint64()}
+	if err := c.AssignUnit(action); err == nil {
+		t.Errorf("expected error assigning repair to an undamaged building")
+	}
+
+	c.GetBuilding(1, 1).SetIntegrity(82)
+	mustNotFail(t, c.AssignUnit(action))
+	startTestMatch(t, c, 16)
+
+	if integrity := c.GetBuilding(1, 1).GetIntegrity(); integrity != 100 {
+		t.Errorf("expected integrity %v, got %v", 100, integrity)
+	}
+	repairCost := uint16(c.GetUnitPrototype(testProtoId_Worker).GetRepairResourceCost())
+	if resource := c.GetPlayer(1).GetCurResource(); resource != 1000-4*repairCost {
+		t.Errorf("expected resource %v, got %v", 1000-4*repairCost, resource)
+	}
+	if commandType := WorkerCommandData(c.GetUnit(1, 1).GetCommand()).Type(); !commandType.IsIdle() {
+		t.Errorf("expected worker to be idle, got %v", commandType)
+	}
+}
+
+func TestSplashDamage(t *testing.T) {
+	c := newTestMatch(t)
+	protoId := c.GetMeta().GetUnitPrototypeCount() + 1
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), SpawnTime: 1, MaxIntegrity: 20, LandStrength: 4, AttackRange: 1, AttackCooldown: 8, VisionRadius: 2,
+		SplashRadius: 1, SplashFalloff: 50,
+	}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: protoId, X: 3, Y: 1}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: testProtoId_Dummy, X: 4, Y: 1})) // Target
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: testProtoId_Dummy, X: 5, Y: 1})) // In splash radius
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: testProtoId_Dummy, X: 6, Y: 1})) // Out of splash radius
+
+	shots := make([]*InternalEvent_Shot, 0)
+	SubscribeTo(c, func(shot *InternalEvent_Shot) {
+		shots = append(shots, shot)
+	})
+	startTestMatch(t, c, 0)
+	for i := 0; i < 8 && len(shots) == 0; i++ {
+		runTestBlocks(c, 1)
+	}
+
+	for unitId, expected := range map[uint8]uint8{1: 6, 2: 8, 3: 10} {
+		if integrity := c.GetUnit(2, unitId).GetIntegrity(); integrity != expected {
+			t.Errorf("unit %d: expected integrity %d, got %d", unitId, expected, integrity)
+		}
+	}
+	if len(shots) != 2 {
+		t.Fatalf("expected 2 shot events, got %d", len(shots))
+	}
+	if shots[0].IsSplash || shots[0].Target.ObjectId != 1 {
+		t.Errorf("expected primary shot on unit 1, got %+v", shots[0])
+	}
+	if !shots[1].IsSplash || shots[1].Target.ObjectId != 2 {
+		t.Errorf("expected splash shot on unit 2, got %+v", shots[1])
+	}
+}
+
+func TestDamageMatrix(t *testing.T) {
+	c := newTestMatch(t)
+	if strength := c.GetUnitAttackStrength(testProtoId_Fighter, testProtoId_Dummy); strength != 5 {
+		t.Errorf("expected default strength 5, got %d", strength)
+	}
+	mustNotFail(t, c.SetDamage(&DamageSetting{AttackerType: testProtoId_Fighter, TargetType: testProtoId_Dummy, Strength: 2}))
+	mustNotFail(t, c.SetDamage(&DamageSetting{AttackerType: testProtoId_Fighter, TargetType: testProtoId_Fighter, Strength: 0}))
+	if strength := c.GetUnitAttackStrength(testProtoId_Fighter, testProtoId_Dummy); strength != 2 {
+		t.Errorf("expected overridden strength 2, got %d", strength)
+	}
+	if strength := c.GetUnitAttackStrength(testProtoId_Fighter, testProtoId_Fighter); strength != 0 {
+		t.Errorf("expected overridden strength 0, got %d", strength)
+	}
+	if err := c.SetDamage(&DamageSetting{AttackerType: testProtoId_Fighter, TargetType: 0xff, Strength: 1}); err != ErrInvalidUnitType {
+		t.Errorf("expected %v, got %v", ErrInvalidUnitType, err)
+	}
+
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 3, Y: 1}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: testProtoId_Dummy, X: 4, Y: 1}))
+	shots := 0
+	SubscribeTo(c, func(*InternalEvent_Shot) {
+		shots++
+	})
+	startTestMatch(t, c, 0)
+	if err := c.SetDamage(&DamageSetting{AttackerType: testProtoId_Fighter, TargetType: testProtoId_Dummy, Strength: 1}); err != ErrAlreadyStarted {
+		t.Errorf("expected %v, got %v", ErrAlreadyStarted, err)
+	}
+	for i := 0; i < 8 && shots == 0; i++ {
+		runTestBlocks(c, 1)
+	}
+	if shots == 0 {
+		t.Fatal("expected the fighter to shoot")
+	}
+	if integrity := c.GetUnit(2, 1).GetIntegrity(); integrity != 10-2*uint8(shots) {
+		t.Errorf("expected integrity %d after %d shots, got %d", 10-2*shots, shots, integrity)
+	}
+}
+
+func TestUnitIdRecycling(t *testing.T) {
+	c := newTestMatch(t)
+	createUnit := func() error {
+		return c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 1, Y: 1})
+	}
+	for i := 0; i < int(MaxUnitId); i++ {
+		mustNotFail(t, createUnit())
+		c.setUnitDead(c.GetUnitObject(1, c.GetPlayer(1).GetLastUnitId()))
+	}
+	if err := createUnit(); err != ErrUnitLimitReached {
+		t.Fatalf("expected %v while the dead unit ids are reserved, got %v", ErrUnitLimitReached, err)
+	}
+
+	startTestMatch(t, c, UnitIdReuseDelay)
+	mustNotFail(t, createUnit())
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 2, Y: 1}))
+	player := c.GetPlayer(1)
+	if lastUnitId := player.GetLastUnitId(); lastUnitId != 2 {
+		t.Errorf("expected recycled unit id 2, got %d", lastUnitId)
+	}
+	if unitCount := player.GetUnitCount(); unitCount != MaxUnitId {
+		t.Errorf("expected unit count %d, got %d", MaxUnitId, unitCount)
+	}
+
+	runTestBlocks(c, 4)
+	for unitId := uint8(1); unitId <= 2; unitId++ {
+		if state := UnitState(c.GetUnit(1, unitId).GetState()); state != UnitState_Active {
+			t.Errorf("unit %d: expected state %v, got %v", unitId, UnitState_Active, state)
+		}
+	}
+	if payPointer := player.GetUnitPayQueuePointer(); payPointer != 3 {
+		t.Errorf("expected pay queue pointer 3, got %d", payPointer)
+	}
+}
+
+func TestUnitIdRecyclingSkipsLiveUnits(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 2, Y: 1}))
+	for i := 1; i < int(MaxUnitId); i++ {
+		mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 1, Y: 1}))
+		c.setUnitDead(c.GetUnitObject(1, c.GetPlayer(1).GetLastUnitId()))
+	}
+	startTestMatch(t, c, UnitIdReuseDelay)
+
+	// Unit 1 is next in the id ring but still alive, so the first free id after it is used
+	player := c.GetPlayer(1)
+	resources := player.GetCurResource()
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 1, Y: 1}))
+	if lastUnitId := player.GetLastUnitId(); lastUnitId != 2 {
+		t.Fatalf("expected recycled unit id 2, got %d", lastUnitId)
+	}
+	if state := UnitState(c.GetUnit(1, 1).GetState()); state != UnitState_Active {
+		t.Errorf("expected unit 1 to stay %v, got %v", UnitState_Active, state)
+	}
+
+	runTestBlocks(c, 4)
+	if state := UnitState(c.GetUnit(1, 2).GetState()); state != UnitState_Active {
+		t.Errorf("expected unit 2 to be spawned, got state %v", state)
+	}
+	cost := c.GetUnitPrototype(testProtoId_Dummy).GetResourceCost()
+	if spent := resources - player.GetCurResource(); spent != cost {
+		t.Errorf("expected to pay %d for the new unit only, paid %d", cost, spent)
+	}
+	if payPointer := player.GetUnitPayQueuePointer(); payPointer != 3 {
+		t.Errorf("expected pay queue pointer 3, got %d", payPointer)
+	}
+}
+
+func TestGameOver(t *testing.T) {
+	c := newTestMatch(t)
+	startTestMatch(t, c, 1)
+	if c.IsGameOver() {
+		t.Fatal("expected match to be running")
+	}
+
+	mainBuilding := c.GetBuildingObject(2, 1)
+	mainBuilding.Building().SetIntegrity(0)
+	c.setBuildingDestroyed(mainBuilding)
+	runTestBlocks(c, 1)
+
+	meta := c.GetMeta()
+	if !meta.GetIsGameOver() {
+		t.Fatal("expected match to be over")
+	}
+	if winnerId := meta.GetWinnerId(); winnerId != 1 {
+		t.Errorf("expected winner 1, got %d", winnerId)
+	}
+	endTick := meta.GetEndTick()
+	runTestBlocks(c, 4)
+	if meta.GetEndTick() != endTick {
+		t.Errorf("expected end tick to stay %d, got %d", endTick, meta.GetEndTick())
+	}
+	if err := c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 1, Y: 1}); err != ErrGameOver {
+		t.Errorf("expected %v, got %v", ErrGameOver, err)
+	}
+	if err := c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: 1, X: 5, Y: 0}); err != ErrGameOver {
+		t.Errorf("expected %v, got %v", ErrGameOver, err)
+	}
+}
+
+func TestGameOverDrawBeforeTimeLimit(t *testing.T) {
+	c := newTestMatch(t)
+	c.GetMeta().SetMaxTicks(8)
+	startTestMatch(t, c, 1)
+	for playerId := uint8(1); playerId <= 2; playerId++ {
+		mainBuilding := c.GetBuildingObject(playerId, 1)
+		mainBuilding.Building().SetIntegrity(0)
+		c.setBuildingDestroyed(mainBuilding)
+	}
+	runTestBlocks(c, 1)
+	if !c.IsGameOver() {
+		t.Fatal("expected match to be over")
+	}
+
+	// The end reason must not change once the tick limit passes after the match is over
+	runTestBlocks(c, 16)
+	if !c.IsOutOfTime() {
+		t.Fatal("expected the tick limit to have passed")
+	}
+	if c.EndedOutOfTime() {
+		t.Error("expected the match to have ended by elimination")
+	}
+}
+
+func TestGameOverOutOfTime(t *testing.T) {
+	c := newTestMatch(t)
+	c.GetMeta().SetMaxTicks(8)
+	startTestMatch(t, c, 8)
+	if c.IsGameOver() {
+		t.Fatal("expected match to be running")
+	}
+	runTestBlocks(c, 1)
+	if !c.IsGameOver() {
+		t.Fatal("expected match to be over")
+	}
+	if winnerId := c.GetMeta().GetWinnerId(); winnerId != NilPlayerId {
+		t.Errorf("expected no winner, got %d", winnerId)
+	}
+	if !c.EndedOutOfTime() {
+		t.Error("expected the match to have ended out of time")
+	}
+}
+
+func TestSurrender(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 2, UnitType: testProtoId_Dummy, X: 8, Y: 1}))
+	if err := c.Surrender(&Surrender{PlayerId: 2}); err != ErrNotStarted {
+		t.Errorf("expected %v, got %v", ErrNotStarted, err)
+	}
+	startTestMatch(t, c, 1)
+
+	mustNotFail(t, c.Surrender(&Surrender{PlayerId: 2}))
+	if state := BuildingState(c.GetMainBuilding(2).GetState()); state != BuildingState_Destroyed {
+		t.Errorf("expected main building to be destroyed, got state %v", state)
+	}
+	if state := UnitState(c.GetUnit(2, 1).GetState()); state != UnitState_Dead {
+		t.Errorf("expected unit to be dead, got state %v", state)
+	}
+	if tile := c.GetBoardTile(8, 1); !IsTileEmpty(tile, LayerId_Land) {
+		t.Error("expected the dead unit's tile to be empty")
+	}
+	if !c.GetPlayer(2).GetIsEliminated() {
+		t.Error("expected player 2 to be eliminated")
+	}
+	if err := c.Surrender(&Surrender{PlayerId: 2}); err != ErrPlayerEliminated {
+		t.Errorf("expected %v, got %v", ErrPlayerEliminated, err)
+	}
+
+	runTestBlocks(c, 1)
+	if winnerId := c.GetMeta().GetWinnerId(); !c.IsGameOver() || winnerId != 1 {
+		t.Errorf("expected player 1 to win, got game over %v and winner %d", c.IsGameOver(), winnerId)
+	}
+}
+
+func TestEventSubscriptions(t *testing.T) {
+	c := newTestMatch(t)
+	events := make([]InternalEvent, 0)
+	unsubscribe := c.Subscribe(func(event InternalEvent) {
+		events = append(events, event)
+	})
+	eliminated := make([]uint8, 0)
+	SubscribeTo(c, func(event *InternalEvent_PlayerEliminated) {
+		eliminated = append(eliminated, event.PlayerId)
+	})
+	startTestMatch(t, c, 1)
+
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Fighter, X: 1, Y: 1}))
+	runTestBlocks(c, 1)
+	var paid *InternalEvent_UnitPaid
+	for _, event := range events {
+		if event, ok := event.(*InternalEvent_UnitPaid); ok {
+			paid = event
+		}
+	}
+	if paid == nil {
+		t.Fatal("expected a unit paid event")
+	}
+	if paid.Unit != (Object{Type: ObjectType_Unit, PlayerId: 1, ObjectId: 1}) {
+		t.Errorf("expected unit 1:1 to be paid, got %+v", paid.Unit)
+	}
+	// Actions and events after the block ticks share the index of the block's first sub-tick
+	if paid.TickIndex() != c.AbsSubTickIndex() {
+		t.Errorf("expected the event at tick %d, got %d", c.AbsSubTickIndex(), paid.TickIndex())
+	}
+
+	unsubscribe()
+	nEvents := len(events)
+	mustNotFail(t, c.Surrender(&Surrender{PlayerId: 2}))
+	if len(events) != nEvents {
+		t.Errorf("expected no events after unsubscribing, got %d", len(events)-nEvents)
+	}
+	if len(eliminated) != 1 || eliminated[0] != 2 {
+		t.Errorf("expected player 2 to be eliminated once, got %v", eliminated)
+	}
+	runTestBlocks(c, 1)
+	if len(eliminated) != 1 {
+		t.Errorf("expected player 2 to be eliminated once, got %v", eliminated)
+	}
+}
+
+func TestPause(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), SpawnTime: 4, MaxIntegrity: 10, VisionRadius: 2,
+	}))
+	slowProtoId := c.GetMeta().GetUnitPrototypeCount()
+	startTestMatch(t, c, 1)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: slowProtoId, X: 2, Y: 1}))
+	runTestBlocks(c, 2)
+	if state := UnitState(c.GetUnit(1, 1).GetState()); state != UnitState_Spawning {
+		t.Fatalf("expected unit to be spawning, got state %v", state)
+	}
+
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 1}))
+	if c.IsPaused() {
+		t.Fatal("expected the match not to be paused until every player agrees")
+	}
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 2}))
+	if !c.IsPaused() {
+		t.Fatal("expected the match to be paused")
+	}
+	if err := c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 3, Y: 1}); err != ErrPaused {
+		t.Errorf("expected %v, got %v", ErrPaused, err)
+	}
+
+	subTickIndex := c.AbsSubTickIndex()
+	state := c.GetUnit(1, 1).GetState()
+	runTestBlocks(c, 4)
+	if c.AbsSubTickIndex() != subTickIndex {
+		t.Errorf("expected sub-tick index to stay at %d while paused, got %d", subTickIndex, c.AbsSubTickIndex())
+	}
+	if newState := c.GetUnit(1, 1).GetState(); newState != state {
+		t.Errorf("expected unit state to stay %v while paused, got %v", UnitState(state), UnitState(newState))
+	}
+
+	if pausedBy := c.GetMeta().GetPausedBy(); pausedBy != 1 {
+		t.Errorf("expected the match to be paused by player 1, got %d", pausedBy)
+	}
+	if err := c.Resume(&Resumption{PlayerId: 2}); err != ErrPausedByOtherPlayer {
+		t.Errorf("expected %v, got %v", ErrPausedByOtherPlayer, err)
+	}
+	mustNotFail(t, c.Resume(&Resumption{PlayerId: 1}))
+	if c.IsPaused() || c.GetPlayer(1).GetIsPauseRequested() {
+		t.Fatal("expected the match to be resumed and pause requests cleared")
+	}
+	if err := c.Resume(&Resumption{PlayerId: 2}); err != ErrNotPaused {
+		t.Errorf("expected %v, got %v", ErrNotPaused, err)
+	}
+	runTestBlocks(c, 2)
+	if c.AbsSubTickIndex() != subTickIndex+2 {
+		t.Errorf("expected sub-tick index %d, got %d", subTickIndex+2, c.AbsSubTickIndex())
+	}
+	if state := UnitState(c.GetUnit(1, 1).GetState()); state != UnitState_Spawning {
+		t.Errorf("expected unit to be spawning, got state %v", state)
+	}
+	runTestBlocks(c, 2)
+	if state := UnitState(c.GetUnit(1, 1).GetState()); state != UnitState_Active {
+		t.Errorf("expected unit to be spawned, got state %v", state)
+	}
+}
+
+func TestPauseTimeout(t *testing.T) {
+	c := newTestMatch(t)
+	startTestMatch(t, c, 1)
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 1}))
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 2}))
+	subTickIndex := c.AbsSubTickIndex()
+
+	runTestBlocks(c, MaxPauseTicks-1)
+	if !c.IsPaused() {
+		t.Fatal("expected the match to stay paused until the pause times out")
+	}
+	runTestBlocks(c, 1)
+	if c.IsPaused() || c.GetMeta().GetPausedBy() != NilPlayerId || c.GetPlayer(1).GetIsPauseRequested() {
+		t.Fatal("expected the match to resume once the pause times out")
+	}
+	if c.AbsSubTickIndex() != subTickIndex {
+		t.Errorf("expected sub-tick index to stay at %d, got %d", subTickIndex, c.AbsSubTickIndex())
+	}
+	runTestBlocks(c, 1)
+	if c.AbsSubTickIndex() != subTickIndex+1 {
+		t.Errorf("expected sub-tick index %d, got %d", subTickIndex+1, c.AbsSubTickIndex())
+	}
+
+	// A new pause gets the full length again
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 1}))
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 2}))
+	runTestBlocks(c, MaxPauseTicks-1)
+	if !c.IsPaused() {
+		t.Fatal("expected the new pause to last until it times out")
+	}
+}
+
+func TestSurrenderWhilePaused(t *testing.T) {
+	c := newTestMatch(t)
+	startTestMatch(t, c, 1)
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 1}))
+	mustNotFail(t, c.RequestPause(&PauseRequest{PlayerId: 2}))
+	mustNotFail(t, c.Surrender(&Surrender{PlayerId: 2}))
+	if c.IsPaused() {
+		t.Error("expected the match to resume when a player surrenders")
+	}
+	runTestBlocks(c, 1)
+	if winnerId := c.GetMeta().GetWinnerId(); !c.IsGameOver() || winnerId != 1 {
+		t.Errorf("expected player 1 to win, got game over %v and winner %d", c.IsGameOver(), winnerId)
+	}
+}
+
+func TestTicksPerBlock(t *testing.T) {
+	c := &Core{}
+	c.SetKV(kvstore.NewMemoryKeyValueStore())
+	c.SetDefaultTicksPerBlock(2)
+	if c.TicksPerBlock() != 2 {
+		t.Errorf("expected default ticks per block %d before initialization, got %d", 2, c.TicksPerBlock())
+	}
+	mustNotFail(t, c.Initialize(&Initialization{Width: 12, Height: 3, TicksPerBlock: 4}))
+	if c.TicksPerBlock() != 4 {
+		t.Fatalf("expected ticks per block %d, got %d", 4, c.TicksPerBlock())
+	}
+
+	c = newTestMatch(t)
+	c.GetMeta().SetTicksPerBlock(4)
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), SpawnTime: 4, MaxIntegrity: 10, VisionRadius: 2,
+	}))
+	slowProtoId := c.GetMeta().GetUnitPrototypeCount()
+	startTestMatch(t, c, 1)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: slowProtoId, X: 2, Y: 1}))
+
+	// Run the next block one sub-tick at a time as the client does when anticipating ticks
+	c.SetBlockNumber(c.BlockNumber() + 1)
+	c.SetInBlockTickIndex(0)
+	blockStartIndex := c.AbsSubTickIndex()
+	for i := uint64(0); i < c.TicksPerBlock(); i++ {
+		c.SetInBlockTickIndex(i)
+		if subTickIndex := c.AbsSubTickIndex(); subTickIndex != blockStartIndex+uint32(i) {
+			t.Errorf("expected sub-tick index %d, got %d", blockStartIndex+uint32(i), subTickIndex)
+		}
+		arch.RunSingleTick(c)
+	}
+	if state := UnitState(c.GetUnit(1, 1).GetState()); state != UnitState_Spawning {
+		t.Errorf("expected unit to be spawning, got state %v", state)
+	}
+
+	// Once the block ticks have run the index stays at the first sub-tick of the block, as on chain
+	runTestBlocks(c, 1)
+	if c.AbsSubTickIndex() != blockStartIndex+4 {
+		t.Errorf("expected sub-tick index %d, got %d", blockStartIndex+4, c.AbsSubTickIndex())
+	}
+	if state := UnitState(c.GetUnit(1, 1).GetState()); state != UnitState_Active {
+		t.Errorf("expected unit to be active after %d sub-ticks, got state %v", 2*4, state)
+	}
+}
+
+// TestMineDepletion uses its own 8x3 board as the test match leaves no room for mines outside the
+// spawn areas. Main buildings are at (0, 0) and (7, 0) and mines at (3, 1) and (5, 1).
+func TestMineDepletion(t *testing.T) {
+	c := newTestCore(t, 8, 3)
+	mustNotFail(t, c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 1, Height: 1, MaxIntegrity: 100, ResourceCapacity: 1000, ComputeCapacity: 16, VisionRadius: 2,
+	}))
+	mustNotFail(t, c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 1, Height: 1, ResourceMine: 10, IsEnvironment: true,
+	}))
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Hover), SpawnTime: 1, MaxIntegrity: 10, VisionRadius: 2, IsWorker: true,
+	}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 1, SpawnAreaWidth: 1, SpawnAreaHeight: 3}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 7, SpawnAreaY: 1, SpawnAreaWidth: 1, SpawnAreaHeight: 2}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: 1, X: 0, Y: 0}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 2, BuildingType: 1, X: 7, Y: 0}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: NilPlayerId, BuildingType: 2, X: 3, Y: 1}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: NilPlayerId, BuildingType: 2, X: 5, Y: 1}))
+	mustNotFail(t, c.SetMineReserve(&MineReserveSetting{BuildingId: 1, ResourceReserve: 25}))
+	if err := c.SetMineReserve(&MineReserveSetting{BuildingId: 3, ResourceReserve: 25}); err == nil {
+		t.Errorf("expected error setting the reserve of a nonexistent mine")
+	}
+
+	harvested := make(map[uint8]uint16)
+	SubscribeTo(c, func(event *InternalEvent_MineHarvested) {
+		harvested[event.Mine.ObjectId] += uint16(event.Amount)
+	})
+	depleted := make([]uint8, 0)
+	SubscribeTo(c, func(event *InternalEvent_MineDepleted) {
+		depleted = append(depleted, event.Mine.ObjectId)
+	})
+
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: 1, X: 1, Y: 1}))
+	command := NewWorkerCommandData(WorkerCommandType_Gather)
+	command.SetTargetBuilding(NilPlayerId, 1)
+	action := &UnitAssignation{PlayerId: 1, UnitId: 1, Command: command.Uint64()}
+	mustNotFail(t, c.AssignUnit(action))
+	startTestMatch(t, c, 64)
+
+	if err := c.SetMineReserve(&MineReserveSetting{BuildingId: 2, ResourceReserve: 25}); err != ErrAlreadyStarted {
+		t.Errorf("expected %v, got %v", ErrAlreadyStarted, err)
+	}
+	if state := BuildingState(c.GetBuilding(NilPlayerId, 1).GetState()); state != BuildingState_Depleted {
+		t.Errorf("expected mine 1 to be depleted, got state %v", state)
+	}
+	if harvested[1] != 25 {
+		t.Errorf("expected 25 resources harvested from mine 1, got %v", harvested[1])
+	}
+	if len(depleted) != 1 || depleted[0] != 1 {
+		t.Errorf("expected mine 1 to be depleted once, got %v", depleted)
+	}
+	// The worker moves on to the mine with resources left, which is unlimited
+	if harvested[2] == 0 {
+		t.Errorf("expected resources harvested from mine 2")
+	}
+	if state := BuildingState(c.GetBuilding(NilPlayerId, 2).GetState()); state != BuildingState_Built {
+		t.Errorf("expected mine 2 to be built, got state %v", state)
+	}
+	if buildingId := WorkerCommandData(c.GetUnit(1, 1).GetCommand()).TargetBuildingId(); buildingId != 2 {
+		t.Errorf("expected worker to gather from mine 2, got %v", buildingId)
+	}
+	if err := c.AssignUnit(action); err == nil {
+		t.Errorf("expected error assigning gather to a depleted mine")
+	}
+}
+
+func TestCancelUnit(t *testing.T) {
+	c := newTestMatch(t)
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), ResourceCost: 600, ComputeCost: 1, SpawnTime: 64, MaxIntegrity: 10, VisionRadius: 2,
+	}))
+	expensiveProtoId := c.GetMeta().GetUnitPrototypeCount()
+	cancelled := make([]uint8, 0)
+	SubscribeTo(c, func(event *InternalEvent_Cancelled) {
+		cancelled = append(cancelled, event.Unit.ObjectId)
+	})
+	startTestMatch(t, c, 1)
+
+	// Unit 1 is paid for and spawning, unit 2 cannot be paid for and blocks units 3 and 4
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: expensiveProtoId, X: 1, Y: 0}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: expensiveProtoId, X: 2, Y: 0}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 3, Y: 0}))
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: testProtoId_Dummy, X: 1, Y: 1}))
+	runTestBlocks(c, 1)
+	if state := UnitState(c.GetUnit(1, 1).GetState()); state != UnitState_Spawning {
+		t.Fatalf("expected unit 1 to be spawning, got %v", state)
+	}
+	if payPointer := c.GetPlayer(1).GetUnitPayQueuePointer(); payPointer != 2 {
+		t.Fatalf("expected pay pointer at 2, got %v", payPointer)
+	}
+
+	// Cancelling units at and behind the head of the pay queue moves the pay pointer past both
+	mustNotFail(t, c.CancelUnit(&UnitCancellation{PlayerId: 1, UnitId: 3}))
+	if payPointer := c.GetPlayer(1).GetUnitPayQueuePointer(); payPointer != 2 {
+		t.Errorf("expected pay pointer at 2, got %v", payPointer)
+	}
+	mustNotFail(t, c.CancelUnit(&UnitCancellation{PlayerId: 1, UnitId: 2}))
+	if payPointer := c.GetPlayer(1).GetUnitPayQueuePointer(); payPointer != 4 {
+		t.Errorf("expected pay pointer at 4, got %v", payPointer)
+	}
+	if tile := c.GetBoardTile(2, 0); !IsTileEmptyAllLayers(tile) {
+		t.Errorf("expected the spawn tile of unit 2 to be freed")
+	}
+	runTestBlocks(c, 1)
+	if state := UnitState(c.GetUnit(1, 4).GetState()); !state.IsPaid() {
+		t.Errorf("expected unit 4 to be paid for, got %v", state)
+	}
+	if resource := c.GetPlayer(1).GetCurResource(); resource != 400 {
+		t.Errorf("expected resource %v, got %v", 400, resource)
+	}
+
+	// Cancelling a spawning unit refunds it
+	mustNotFail(t, c.CancelUnit(&UnitCancellation{PlayerId: 1, UnitId: 1}))
+	if resource := c.GetPlayer(1).GetCurResource(); resource != 1000 {
+		t.Errorf("expected resource %v, got %v", 1000, resource)
+	}
+	if computeDemand := c.GetPlayer(1).GetComputeDemand(); computeDemand != 0 {
+		t.Errorf("expected compute demand %v, got %v", 0, computeDemand)
+	}
+	if tile := c.GetBoardTile(1, 0); !IsTileEmptyAllLayers(tile) {
+		t.Errorf("expected the spawn tile of unit 1 to be freed")
+	}
+
+	runTestBlocks(c, 4)
+	if err := c.CancelUnit(&UnitCancellation{PlayerId: 1, UnitId: 4}); err != ErrUnitNotCancellable {
+		t.Errorf("expected %v, got %v", ErrUnitNotCancellable, err)
+	}
+	if len(cancelled) != 3 || cancelled[0] != 3 || cancelled[1] != 2 || cancelled[2] != 1 {
+		t.Errorf("expected units 3, 2 and 1 to be cancelled, got %v", cancelled)
+	}
+}
+
+func TestDemolishBuilding(t *testing.T) {
+	c := newTestCore(t, 8, 3)
+	c.GetMeta().SetDemolitionRefundPercent(50)
+	mustNotFail(t, c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 1, Height: 1, MaxIntegrity: 100, ResourceCapacity: 1000, ComputeCapacity: 16, VisionRadius: 2,
+	}))
+	mustNotFail(t, c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 1, Height: 1, MaxIntegrity: 50, ResourceCost: 200, ResourceCapacity: 500, ComputeCapacity: 4,
+		BuildingTime: 64, VisionRadius: 2, IsArmory: true,
+	}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 1, SpawnAreaWidth: 1, SpawnAreaHeight: 3}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 7, SpawnAreaY: 1, SpawnAreaWidth: 1, SpawnAreaHeight: 2}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: 1, X: 0, Y: 0}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 2, BuildingType: 1, X: 7, Y: 0}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: 2, X: 3, Y: 1}))
+	demolished := make([]uint8, 0)
+	SubscribeTo(c, func(event *InternalEvent_Demolished) {
+		demolished = append(demolished, event.Building.ObjectId)
+	})
+
+	action := &BuildingDemolition{PlayerId: 1, BuildingId: 2}
+	if err := c.DemolishBuilding(action); err != ErrNotStarted {
+		t.Errorf("expected %v, got %v", ErrNotStarted, err)
+	}
+	startTestMatch(t, c, 1)
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: 2, X: 5, Y: 1}))
+	runTestBlocks(c, 1)
+
+	player := c.GetPlayer(1)
+	player.SetCurResource(700)
+	mustNotFail(t, c.DemolishBuilding(action))
+	if state := BuildingState(c.GetBuilding(1, 2).GetState()); state != BuildingState_Destroyed {
+		t.Errorf("expected building 2 to be destroyed, got state %v", state)
+	}
+	if tile := c.GetBoardTile(3, 1); !IsTileEmptyAllLayers(tile) {
+		t.Errorf("expected the tile of building 2 to be freed")
+	}
+	// Half of the cost is refunded after the building storage is removed
+	if resource := player.GetCurResource(); resource != 800 {
+		t.Errorf("expected resource %v, got %v", 800, resource)
+	}
+	if maxResource := player.GetMaxResource(); maxResource != 1000 {
+		t.Errorf("expected max resource %v, got %v", 1000, maxResource)
+	}
+	if computeSupply := player.GetComputeSupply(); computeSupply != 16 {
+		t.Errorf("expected compute supply %v, got %v", 16, computeSupply)
+	}
+	if armories := player.GetCurArmories(); armories != 0 {
+		t.Errorf("expected %v armories, got %v", 0, armories)
+	}
+
+	if err := c.DemolishBuilding(action); err != ErrBuildingNotDemolishable {
+		t.Errorf("expected %v demolishing a destroyed building, got %v", ErrBuildingNotDemolishable, err)
+	}
+	if err := c.DemolishBuilding(&BuildingDemolition{PlayerId: 1, BuildingId: 3}); err != ErrBuildingNotDemolishable {
+		t.Errorf("expected %v demolishing an unfinished building, got %v", ErrBuildingNotDemolishable, err)
+	}
+	if err := c.DemolishBuilding(&BuildingDemolition{PlayerId: 1, BuildingId: 1}); err != ErrBuildingNotDemolishable {
+		t.Errorf("expected %v demolishing the main building, got %v", ErrBuildingNotDemolishable, err)
+	}
+	if len(demolished) != 1 || demolished[0] != 2 {
+		t.Errorf("expected building 2 to be demolished, got %v", demolished)
+	}
+}
@@ -0,0 +1,124 @@
+package rts
+
+import (
+	"image"
+
+	"github.com/concrete-eth/ark-royale/gogen/datamod"
+)
+
+// Side length in tiles of the square buckets the unit index partitions the board into.
+const unitIndexBucketSize = 4
+
+type indexedUnit struct {
+	playerId uint8
+	unitId   uint8
+	layer    LayerId
+	position image.Point
+}
+
+// unitIndex buckets the units on the board by position so range queries only visit the buckets
+// the range overlaps.
+// It mirrors the unit references on the board tiles and is kept up to date by setTileUnit and
+// emptyUnitTile. Results do not depend on the order units are stored in within a bucket.
+type unitIndex struct {
+	size    image.Point
+	buckets [][]indexedUnit
+}
+
+func newUnitIndex(boardSize image.Point) *unitIndex {
+	size := image.Point{
+		X: (boardSize.X + unitIndexBucketSize - 1) / unitIndexBucketSize,
+		Y: (boardSize.Y + unitIndexBucketSize - 1) / unitIndexBucketSize,
+	}
+	return &unitIndex{
+		size:    size,
+		buckets: make([][]indexedUnit, size.X*size.Y),
+	}
+}
+
+func (x *unitIndex) bucketIndex(position image.Point) int {
+	return (position.Y/unitIndexBucketSize)*x.size.X + position.X/unitIndexBucketSize
+}
+
+func (x *unitIndex) add(unit indexedUnit) {
+	idx := x.bucketIndex(unit.position)
+	x.buckets[idx] = append(x.buckets[idx], unit)
+}
+
+func (x *unitIndex) remove(position image.Point, layer LayerId, playerId uint8, unitId uint8) {
+	idx := x.bucketIndex(position)
+	bucket := x.buckets[idx]
+	for ii, unit := range bucket {
+		if unit.playerId == playerId && unit.unitId == unitId && unit.layer == layer && unit.position.Eq(position) {
+			bucket[ii] = bucket[len(bucket)-1]
+			x.buckets[idx] = bucket[:len(bucket)-1]
+			return
+		}
+	}
+}
+
+// Calls forEach for every indexed unit within the given distance of position.
+func (x *unitIndex) forEachInRange(position image.Point, _range int, forEach func(unit indexedUnit, distance int)) {
+	var (
+		minBucket = image.Point{
+			X: max(position.X-_range, 0) / unitIndexBucketSize,
+			Y: max(position.Y-_range, 0) / unitIndexBucketSize,
+		}
+		maxBucket = image.Point{
+			X: min((position.X+_range)/unitIndexBucketSize, x.size.X-1),
+			Y: min((position.Y+_range)/unitIndexBucketSize, x.size.Y-1),
+		}
+	)
+	for by := minBucket.Y; by <= maxBucket.Y; by++ {
+		for bx := minBucket.X; bx <= maxBucket.X; bx++ {
+			for _, unit := range x.buckets[by*x.size.X+bx] {
+				if distance := Distance(position, unit.position); distance <= _range {
+					forEach(unit, distance)
+				}
+			}
+		}
+	}
+}
+
+// Builds an index of the units currently on the board.
+func (c *Core) buildUnitIndex() *unitIndex {
+	index := newUnitIndex(c.BoardSize())
+	nPlayers := c.GetMeta().GetPlayerCount()
+	for playerId := uint8(1); playerId < nPlayers+1; playerId++ {
+		c.ForEachUnit(playerId, func(unitId uint8, unit *datamod.UnitsRow) {
+			if !UnitState(unit.GetState()).IsOnBoard() {
+				return
+			}
+			index.add(indexedUnit{
+				playerId: playerId,
+				unitId:   unitId,
+				layer:    LayerId(c.GetUnitPrototype(unit.GetUnitType()).GetLayer()),
+				position: GetPositionAsPoint(unit),
+			})
+		})
+	}
+	return index
+}
+
+// Enables the unit index until the returned function is called.
+func (c *Core) enableUnitIndex() func() {
+	if c.unitIndex != nil {
+		return func() {}
+	}
+	c.unitIndex = c.buildUnitIndex()
+	return func() { c.unitIndex = nil }
+}
+
+func (c *Core) setTileUnit(position image.Point, layer LayerId, playerId uint8, unitId uint8) {
+	SetTileUnit(c.GetBoardTile(uint16(position.X), uint16(position.Y)), layer, playerId, unitId)
+	if c.unitIndex != nil {
+		c.unitIndex.add(indexedUnit{playerId: playerId, unitId: unitId, layer: layer, position: position})
+	}
+}
+
+func (c *Core) emptyUnitTile(position image.Point, layer LayerId, playerId uint8, unitId uint8) {
+	EmptyUnitTile(c.GetBoardTile(uint16(position.X), uint16(position.Y)), layer)
+	if c.unitIndex != nil {
+		c.unitIndex.remove(position, layer, playerId, unitId)
+	}
+}
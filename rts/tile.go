@@ -6,6 +6,58 @@ import (
 	"github.com/concrete-eth/ark-royale/gogen/datamod"
 )
 
+type TerrainType uint8
+
+const (
+	TerrainType_Ground TerrainType = iota
+	TerrainType_Water              // Crossed by hover and air units
+	TerrainType_Cliff              // Crossed by air units only
+	TerrainType_Rough              // Crossed by land and air units
+	TerrainType_Count
+)
+
+func (t TerrainType) Uint8() uint8 {
+	return uint8(t)
+}
+
+func (t TerrainType) String() string {
+	switch t {
+	case TerrainType_Ground:
+		return "ground"
+	case TerrainType_Water:
+		return "water"
+	case TerrainType_Cliff:
+		return "cliff"
+	case TerrainType_Rough:
+		return "rough"
+	default:
+		return "unknown"
+	}
+}
+
+// Returns true if units of the given layer can stand on and move across the terrain.
+func (t TerrainType) IsPassable(layer LayerId) bool {
+	switch layer {
+	case LayerId_Land:
+		return t == TerrainType_Ground || t == TerrainType_Rough
+	case LayerId_Hover:
+		return t == TerrainType_Ground || t == TerrainType_Water
+	case LayerId_Air:
+		return true
+	default:
+		panic(fmt.Sprintf("invalid layer: %d", layer))
+	}
+}
+
+// Returns true if buildings can be placed on the terrain.
+func (t TerrainType) IsBuildable() bool {
+	return t == TerrainType_Ground
+}
+
+func GetTileTerrain(tile *datamod.BoardRow) TerrainType {
+	return TerrainType(tile.GetTerrain())
+}
+
 func SetTileLandObject(tile *datamod.BoardRow, objectType ObjectType, playerId uint8, objectId uint8) {
 	tile.SetLandObjectType(objectType.Uint8())
 	tile.SetLandPlayerId(playerId)
@@ -35,6 +87,24 @@ func SetTileUnit(tile *datamod.BoardRow, layer LayerId, playerId uint8, unitId u
 	}
 }
 
+// Returns the player and unit ids of the unit in the given layer of the tile, or nil ids if there is
+// none.
+func GetTileUnit(tile *datamod.BoardRow, layer LayerId) (uint8, uint8) {
+	switch layer {
+	case LayerId_Land:
+		if ObjectType(tile.GetLandObjectType()) != ObjectType_Unit {
+			return NilPlayerId, NilUnitId
+		}
+		return tile.GetLandPlayerId(), tile.GetLandObjectId()
+	case LayerId_Hover:
+		return tile.GetHoverPlayerId(), tile.GetHoverUnitId()
+	case LayerId_Air:
+		return tile.GetAirPlayerId(), tile.GetAirUnitId()
+	default:
+		panic(fmt.Sprintf("invalid layer: %d", layer))
+	}
+}
+
 func EmptyUnitTile(tile *datamod.BoardRow, layer LayerId) {
 	switch layer {
 	case LayerId_Land:
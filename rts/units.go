@@ -75,6 +75,16 @@ func (c UnitState) IsAlive() bool {
 	}
 }
 
+// Returns true if units in this state occupy their tile on the board.
+func (c UnitState) IsOnBoard() bool {
+	switch c {
+	case UnitState_Unpaid, UnitState_Spawning, UnitState_Active:
+		return true
+	default:
+		return false
+	}
+}
+
 func (c UnitState) IsDeadOrInactive() bool {
 	switch c {
 	case UnitState_Inactive, UnitState_Dead:
@@ -102,9 +112,15 @@ const (
 	WorkerCommandType_Idle WorkerCommandType = iota
 	WorkerCommandType_Gather
 	WorkerCommandType_Build
+	WorkerCommandType_Repair
 	WorkerCommandType_Count
 )
 
+const (
+	MaxUnitId        = uint8(0xff)
+	UnitIdReuseDelay = 2 // Sub-ticks a dead unit's id stays reserved so stale commands targeting it are dropped
+)
+
 func (c WorkerCommandType) Uint8() uint8 {
 	return uint8(c)
 }
@@ -114,7 +130,7 @@ func (c WorkerCommandType) IsIdle() bool {
 }
 
 func (c WorkerCommandType) IsBusy() bool {
-	return c == WorkerCommandType_Gather || c == WorkerCommandType_Build
+	return c == WorkerCommandType_Gather || c == WorkerCommandType_Build || c == WorkerCommandType_Repair
 }
 
 // <unused> uint40, CommandType uint8, PlayerId uint8, BuildingId uint8
@@ -169,6 +185,8 @@ func (c WorkerCommandData) String() string {
 		return fmt.Sprintf("Gather [%d, %d]", c.TargetPlayerId(), c.TargetBuildingId())
 	case WorkerCommandType_Build:
 		return fmt.Sprintf("Build [%d, %d]", c.TargetPlayerId(), c.TargetBuildingId())
+	case WorkerCommandType_Repair:
+		return fmt.Sprintf("Repair [%d, %d]", c.TargetPlayerId(), c.TargetBuildingId())
 	default:
 		return "Unknown"
 	}
@@ -182,6 +200,8 @@ const (
 	FighterCommandType_HoldPosition FighterCommandType = iota
 	FighterCommandType_AttackBuilding
 	FighterCommandType_AttackUnit
+	FighterCommandType_AttackMove // Move to a position engaging enemies in range along the way
+	FighterCommandType_Patrol     // Cycle through the command path waypoints and the target position
 	FighterCommandType_Count
 )
 
@@ -198,7 +218,13 @@ func (c FighterCommandType) IsBusy() bool {
 }
 
 func (c FighterCommandType) IsTargetingPosition() bool {
-	return c == FighterCommandType_HoldPosition
+	return c == FighterCommandType_HoldPosition || c == FighterCommandType_AttackMove || c == FighterCommandType_Patrol
+}
+
+// Returns true if the unit engages enemies in range on the way to its target and keeps its
+// command after a kill.
+func (c FighterCommandType) IsAttackMoving() bool {
+	return c == FighterCommandType_AttackMove || c == FighterCommandType_Patrol
 }
 
 func (c FighterCommandType) IsTargetingBuilding() bool {
@@ -301,6 +327,10 @@ func (c FighterCommandData) String() string {
 		return fmt.Sprintf("AttackBuilding [%d, %d]", c.TargetPlayerId(), c.TargetBuildingId())
 	case FighterCommandType_AttackUnit:
 		return fmt.Sprintf("AttackUnit [%d, %d]", c.TargetPlayerId(), c.TargetUnitId())
+	case FighterCommandType_AttackMove:
+		return fmt.Sprintf("AttackMove [%d, %d]", c.TargetPosition().X, c.TargetPosition().Y)
+	case FighterCommandType_Patrol:
+		return fmt.Sprintf("Patrol [%d, %d]", c.TargetPosition().X, c.TargetPosition().Y)
 	default:
 		return "Unknown"
 	}
@@ -337,6 +367,10 @@ func (c *commandPathMeta) IncPointer() {
 	}
 }
 
+func (c *commandPathMeta) ResetPointer() {
+	c.setPointer(0)
+}
+
 func (c commandPathMeta) Pointer() uint8 {
 	return uint8(c) >> 4
 }
@@ -406,6 +440,10 @@ func (c *CommandPath) IncPointer() {
 	c.meta.IncPointer()
 }
 
+func (c *CommandPath) ResetPointer() {
+	c.meta.ResetPointer()
+}
+
 func (c *CommandPath) Pointer() uint8 {
 	return c.meta.Pointer()
 }
@@ -413,3 +451,34 @@ func (c *CommandPath) Pointer() uint8 {
 func (c *CommandPath) CurrentPoint() image.Point {
 	return c.GetPathPoint(int(c.Pointer()))
 }
+
+// UnitMask is a set over the uint8 unit id space. Word i holds the ids from 64*i to 64*i+63, with
+// bit j of the word selecting id 64*i+j.
+type UnitMask [4]uint64
+
+func NewUnitMask(unitIds ...uint8) UnitMask {
+	var m UnitMask
+	for _, unitId := range unitIds {
+		m.Add(unitId)
+	}
+	return m
+}
+
+func (m UnitMask) Has(unitId uint8) bool {
+	return m[unitId/64]&(1<<(unitId%64)) != 0
+}
+
+func (m *UnitMask) Add(unitId uint8) {
+	m[unitId/64] |= 1 << (unitId % 64)
+}
+
+// Returns the ids in the mask in ascending order.
+func (m UnitMask) UnitIds() []uint8 {
+	unitIds := make([]uint8, 0)
+	for id := 0; id < 256; id++ {
+		if m.Has(uint8(id)) {
+			unitIds = append(unitIds, uint8(id))
+		}
+	}
+	return unitIds
+}
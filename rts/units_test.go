@@ -131,3 +131,24 @@ func TestCommandPath(t *testing.T) {
 		t.Errorf("expected current point 0,0, got %v", path.CurrentPoint())
 	}
 }
+
+func TestUnitMask(t *testing.T) {
+	unitIds := []uint8{1, 7, 64, 200, 255}
+	mask := NewUnitMask(unitIds...)
+	if mask[0] != 0x82 || mask[1] != 1 {
+		t.Errorf("expected words %#x and %#x, got %#x and %#x", 0x82, 1, mask[0], mask[1])
+	}
+	if mask.Has(2) {
+		t.Errorf("expected unit 2 not to be in mask")
+	}
+	got := mask.UnitIds()
+	if len(got) != len(unitIds) {
+		t.Fatalf("expected unit ids %v, got %v", unitIds, got)
+	}
+	for i := range unitIds {
+		if got[i] != unitIds[i] {
+			t.Errorf("expected unit ids %v, got %v", unitIds, got)
+			break
+		}
+	}
+}
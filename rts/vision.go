@@ -0,0 +1,98 @@
+package rts
+
+import (
+	"image"
+
+	"github.com/concrete-eth/ark-royale/gogen/datamod"
+)
+
+// Holds the set of board tiles visible to a player.
+type VisionMap struct {
+	size    image.Point
+	visible []bool
+}
+
+func NewVisionMap(size image.Point) *VisionMap {
+	return &VisionMap{
+		size:    size,
+		visible: make([]bool, size.X*size.Y),
+	}
+}
+
+func (v *VisionMap) Size() image.Point {
+	return v.size
+}
+
+func (v *VisionMap) IsVisible(position image.Point) bool {
+	if !position.In(image.Rectangle{Max: v.size}) {
+		return false
+	}
+	return v.visible[position.Y*v.size.X+position.X]
+}
+
+// Returns true if any tile in the area is visible.
+func (v *VisionMap) IsAreaVisible(area image.Rectangle) bool {
+	area = area.Intersect(image.Rectangle{Max: v.size})
+	for x := area.Min.X; x < area.Max.X; x++ {
+		for y := area.Min.Y; y < area.Max.Y; y++ {
+			if v.visible[y*v.size.X+x] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *VisionMap) Reveal(area image.Rectangle) {
+	area = area.Intersect(image.Rectangle{Max: v.size})
+	for x := area.Min.X; x < area.Max.X; x++ {
+		for y := area.Min.Y; y < area.Max.Y; y++ {
+			v.visible[y*v.size.X+x] = true
+		}
+	}
+}
+
+// Returns the area within the given Chebyshev radius of an area.
+func visionArea(area image.Rectangle, radius uint8) image.Rectangle {
+	r := int(radius)
+	return image.Rectangle{
+		Min: area.Min.Sub(image.Point{r, r}),
+		Max: area.Max.Add(image.Point{r, r}),
+	}
+}
+
+// Returns the tiles visible to the given player.
+// A tile is visible if it is within the vision radius of any living unit or building or built
+// building of the player or of their allies.
+func (c *Core) GetVisibleTiles(playerId uint8) *VisionMap {
+	vision := NewVisionMap(c.BoardSize())
+	c.ForEachPlayer(func(allyId uint8, _ *datamod.PlayersRow) {
+		if c.AreAllies(playerId, allyId) {
+			c.revealPlayerVision(vision, allyId)
+		}
+	})
+	return vision
+}
+
+func (c *Core) revealPlayerVision(vision *VisionMap, playerId uint8) {
+	c.ForEachUnit(playerId, func(unitId uint8, unit *datamod.UnitsRow) {
+		if !UnitState(unit.GetState()).IsAlive() {
+			return
+		}
+		var (
+			proto    = c.GetUnitPrototype(unit.GetUnitType())
+			position = GetPositionAsPoint(unit)
+			area     = image.Rectangle{Min: position, Max: position.Add(image.Point{1, 1})}
+		)
+		vision.Reveal(visionArea(area, proto.GetVisionRadius()))
+	})
+	c.ForEachBuilding(playerId, func(buildingId uint8, building *datamod.BuildingsRow) {
+		state := BuildingState(building.GetState())
+		if state != BuildingState_Building && state != BuildingState_Built {
+			return
+		}
+		proto := c.GetBuildingPrototype(building.GetBuildingType())
+		area := c.GetBuildingArea(playerId, buildingId)
+		vision.Reveal(visionArea(area, proto.GetVisionRadius()))
+	})
+}
@@ -0,0 +1,68 @@
+package rts
+
+import (
+	"image"
+	"testing"
+)
+
+// newVisionTestMatch creates a match on a 16x16 board with three players. Players 1 and 2 are allies
+// with main buildings at (0, 0) and (14, 14), and player 3 has its main building at (14, 0). Main
+// buildings are 2x2 with vision radius 1 and units have vision radius 3.
+func newVisionTestMatch(t *testing.T) *Core {
+	c := newTestCore(t, 16, 16)
+	mustNotFail(t, c.AddBuildingPrototype(&BuildingPrototypeAddition{
+		Width: 2, Height: 2, MaxIntegrity: 100, ResourceCapacity: 1000, ComputeCapacity: 16, VisionRadius: 1,
+	}))
+	mustNotFail(t, c.AddUnitPrototype(&UnitPrototypeAddition{
+		Layer: uint8(LayerId_Land), SpawnTime: 1, MaxIntegrity: 10, VisionRadius: 3,
+	}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 0, SpawnAreaY: 2, SpawnAreaWidth: 8, SpawnAreaHeight: 6, TeamId: 1}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 8, SpawnAreaY: 8, SpawnAreaWidth: 6, SpawnAreaHeight: 6, TeamId: 1}))
+	mustNotFail(t, c.AddPlayer(&PlayerAddition{SpawnAreaX: 8, SpawnAreaY: 2, SpawnAreaWidth: 6, SpawnAreaHeight: 6, TeamId: 2}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 1, BuildingType: 1, X: 0, Y: 0}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 2, BuildingType: 1, X: 14, Y: 14}))
+	mustNotFail(t, c.PlaceBuilding(&BuildingPlacement{PlayerId: 3, BuildingType: 1, X: 14, Y: 0}))
+	return c
+}
+
+func assertVisible(t *testing.T, vision *VisionMap, visible bool, positions ...image.Point) {
+	t.Helper()
+	for _, position := range positions {
+		if vision.IsVisible(position) != visible {
+			t.Errorf("expected tile %v visible to be %v", position, visible)
+		}
+	}
+}
+
+func TestBuildingVision(t *testing.T) {
+	c := newVisionTestMatch(t)
+	vision := c.GetVisibleTiles(1)
+	assertVisible(t, vision, true, image.Point{0, 0}, image.Point{1, 1}, image.Point{2, 2}, image.Point{2, 0})
+	assertVisible(t, vision, false, image.Point{3, 0}, image.Point{0, 3}, image.Point{3, 3})
+}
+
+func TestUnitVision(t *testing.T) {
+	c := newVisionTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: 1, X: 5, Y: 5}))
+	vision := c.GetVisibleTiles(1)
+	assertVisible(t, vision, true, image.Point{5, 5}, image.Point{2, 2}, image.Point{8, 8}, image.Point{2, 8}, image.Point{8, 2})
+	assertVisible(t, vision, false, image.Point{9, 5}, image.Point{5, 9}, image.Point{1, 5}, image.Point{5, 1})
+
+	c.setUnitDead(c.GetUnitObject(1, 1))
+	assertVisible(t, c.GetVisibleTiles(1), false, image.Point{5, 5}, image.Point{8, 8})
+}
+
+func TestAlliedVision(t *testing.T) {
+	c := newVisionTestMatch(t)
+	mustNotFail(t, c.CreateUnit(&UnitCreation{PlayerId: 1, UnitType: 1, X: 5, Y: 5}))
+
+	// Player 2 shares player 1's vision, and player 1 sees around player 2's main building
+	assertVisible(t, c.GetVisibleTiles(2), true, image.Point{5, 5}, image.Point{0, 0}, image.Point{15, 15})
+	assertVisible(t, c.GetVisibleTiles(1), true, image.Point{13, 13}, image.Point{15, 15})
+
+	// Player 3 is an enemy and only sees around its own main building
+	vision := c.GetVisibleTiles(3)
+	assertVisible(t, vision, true, image.Point{13, 0}, image.Point{15, 2})
+	assertVisible(t, vision, false, image.Point{5, 5}, image.Point{0, 0}, image.Point{15, 15})
+	assertVisible(t, c.GetVisibleTiles(1), false, image.Point{13, 0})
+}
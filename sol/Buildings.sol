@@ -7,7 +7,10 @@ enum BuildingType {
     Nil,
     Main,
     Pit,
-    Mine
+    Mine,
+    Storage,
+    Lab,
+    Armory
 }
 
 library BuildingPrototypeAdder {
@@ -24,8 +27,10 @@ library BuildingPrototypeAdder {
                 mineTime: 0,
                 maxIntegrity: 250,
                 buildingTime: 0,
+                visionRadius: 4,
                 isArmory: false,
-                isEnvironment: false
+                isEnvironment: false,
+                resourceReserve: 0
             })
         );
 
@@ -41,8 +46,10 @@ library BuildingPrototypeAdder {
                 mineTime: 0,
                 maxIntegrity: 0,
                 buildingTime: 0,
+                visionRadius: 0,
                 isArmory: false,
-                isEnvironment: true
+                isEnvironment: true,
+                resourceReserve: 0
             })
         );
 
@@ -58,8 +65,67 @@ library BuildingPrototypeAdder {
                 mineTime: 0,
                 maxIntegrity: 0,
                 buildingTime: 0,
+                visionRadius: 0,
                 isArmory: false,
-                isEnvironment: true
+                isEnvironment: true,
+                resourceReserve: 0
+            })
+        );
+
+        // Storage
+        core.addBuildingPrototype(
+            ActionData_AddBuildingPrototype({
+                width: 2,
+                height: 2,
+                resourceCost: 100,
+                resourceCapacity: 300,
+                computeCapacity: 0,
+                resourceMine: 0,
+                mineTime: 0,
+                maxIntegrity: 100,
+                buildingTime: 8,
+                visionRadius: 2,
+                isArmory: false,
+                isEnvironment: false,
+                resourceReserve: 0
+            })
+        );
+
+        // Lab
+        core.addBuildingPrototype(
+            ActionData_AddBuildingPrototype({
+                width: 2,
+                height: 2,
+                resourceCost: 150,
+                resourceCapacity: 0,
+                computeCapacity: 4,
+                resourceMine: 0,
+                mineTime: 0,
+                maxIntegrity: 100,
+                buildingTime: 12,
+                visionRadius: 2,
+                isArmory: false,
+                isEnvironment: false,
+                resourceReserve: 0
+            })
+        );
+
+        // Armory
+        core.addBuildingPrototype(
+            ActionData_AddBuildingPrototype({
+                width: 2,
+                height: 2,
+                resourceCost: 200,
+                resourceCapacity: 0,
+                computeCapacity: 0,
+                resourceMine: 0,
+                mineTime: 0,
+                maxIntegrity: 150,
+                buildingTime: 16,
+                visionRadius: 2,
+                isArmory: true,
+                isEnvironment: false,
+                resourceReserve: 0
             })
         );
     }
@@ -15,6 +15,10 @@ import {LibCommand, WorkerCommandType, FighterCommandType} from "./LibCommand.so
 uint8 constant TopLane_Y = 3;
 uint8 constant BottomLane_Y = 4;
 
+uint32 constant MaxTicks = 1800;
+uint8 constant TicksPerBlock = 1;
+uint8 constant DemolitionRefundPercent = 50;
+
 uint8 constant MainBuildingId = 1;
 uint8 constant TopLaneBuildingId = 2;
 uint8 constant BottomLaneBuildingId = 3;
@@ -44,6 +48,58 @@ contract Game is ArchBase {
         return 0;
     }
 
+    // Returns the canonical hash of the Meta, Players, Board, Units and Buildings tables as computed
+    // by rts.ComputeStateHash. Clients compare it against their local state to detect divergence.
+    function stateHash() public view returns (bytes32 hash) {
+        ITables tables = ITables(proxy);
+        RowData_Meta memory meta = tables.getMetaRow();
+        hash = keccak256(abi.encode(hash, meta));
+
+        uint16 playerCount = meta.playerCount;
+        uint8[] memory unitCounts = new uint8[](playerCount + 1);
+        uint8[] memory buildingCounts = new uint8[](playerCount + 1);
+        for (uint16 playerId = 0; playerId <= playerCount; playerId++) {
+            RowData_Players memory player = tables.getPlayersRow(
+                uint8(playerId)
+            );
+            hash = keccak256(abi.encode(hash, player));
+            unitCounts[playerId] = player.unitCount;
+            buildingCounts[playerId] = player.buildingCount;
+        }
+        for (uint16 x = 0; x < meta.boardWidth; x++) {
+            for (uint16 y = 0; y < meta.boardHeight; y++) {
+                hash = keccak256(abi.encode(hash, tables.getBoardRow(x, y)));
+            }
+        }
+        for (uint16 playerId = 0; playerId <= playerCount; playerId++) {
+            for (uint16 unitId = 1; unitId <= unitCounts[playerId]; unitId++) {
+                hash = keccak256(
+                    abi.encode(
+                        hash,
+                        tables.getUnitsRow(uint8(playerId), uint8(unitId))
+                    )
+                );
+            }
+        }
+        for (uint16 playerId = 0; playerId <= playerCount; playerId++) {
+            for (
+                uint16 buildingId = 1;
+                buildingId <= buildingCounts[playerId];
+                buildingId++
+            ) {
+                hash = keccak256(
+                    abi.encode(
+                        hash,
+                        tables.getBuildingsRow(
+                            uint8(playerId),
+                            uint8(buildingId)
+                        )
+                    )
+                );
+            }
+        }
+    }
+
     function addPlayers(bytes memory data) internal {
         address[] memory _players = abi.decode(data, (address[]));
         if (players.length != 2) {
@@ -62,7 +118,12 @@ contract Game is ArchBase {
     function _initialize(bytes memory data) internal override {
         UnitPrototypeAdder.addUnitPrototypes(ICore(proxy));
         BuildingPrototypeAdder.addBuildingPrototypes(ICore(proxy));
-        BoardLib.initialize(ICore(proxy));
+        BoardLib.initialize(
+            ICore(proxy),
+            MaxTicks,
+            TicksPerBlock,
+            DemolitionRefundPercent
+        );
         addPlayers(data);
     }
 
@@ -80,7 +141,7 @@ contract Game is ArchBase {
         assignUnitData.playerId = action.playerId;
         assignUnitData.unitId = ITables(proxy)
             .getPlayersRow(assignUnitData.playerId)
-            .unitCount;
+            .lastUnitId;
 
         uint8 targetPlayerId = otherPlayer(action.playerId);
         uint64 command;
@@ -118,6 +179,52 @@ contract Game is ArchBase {
         ICore(proxy).assignUnit(assignUnitData);
     }
 
+    function assignUnits(ActionData_AssignUnits memory action) public virtual {
+        ICore(proxy).assignUnits(action);
+    }
+
+    function cancelUnit(ActionData_CancelUnit memory action) public virtual {
+        ICore(proxy).cancelUnit(action);
+    }
+
+    function placeBuilding(
+        ActionData_PlaceBuilding memory action
+    ) public virtual {
+        if (
+            action.buildingType != uint8(BuildingType.Storage) &&
+            action.buildingType != uint8(BuildingType.Lab) &&
+            action.buildingType != uint8(BuildingType.Armory)
+        ) {
+            revert("Game: building type not buildable");
+        }
+        ICore(proxy).placeBuilding(action);
+    }
+
+    function demolishBuilding(
+        ActionData_DemolishBuilding memory action
+    ) public virtual {
+        ICore(proxy).demolishBuilding(action);
+    }
+
+    function surrender(ActionData_Surrender memory action) public virtual {
+        uint8 playerId = getPlayerId(msg.sender);
+        if (playerId == 0) {
+            revert("Game: only players can surrender");
+        }
+        action.playerId = playerId;
+        ICore(proxy).surrender(action);
+    }
+
+    function requestPause(
+        ActionData_RequestPause memory action
+    ) public virtual {
+        ICore(proxy).requestPause(action);
+    }
+
+    function resume(ActionData_Resume memory action) public virtual {
+        ICore(proxy).resume(action);
+    }
+
     function archTick() public {
         super.tick();
     }
@@ -130,6 +237,9 @@ contract Game is ArchBase {
         if (needsPurge == NonZeroBoolean_True) {
             return;
         }
+        if (ITables(proxy).getMetaRow().isGameOver) {
+            return;
+        }
         for (uint8 playerId = 1; playerId <= 2; playerId++) {
             if (gasleft() < 10_000) {
                 return;
@@ -142,10 +252,11 @@ contract Game is ArchBase {
                 continue;
             }
             uint8 unitCount = ITables(proxy).getPlayersRow(playerId).unitCount;
-            for (uint8 unitId = 4; unitId <= unitCount; unitId++) {
+            for (uint16 i = 4; i <= unitCount; i++) {
                 if (gasleft() < 10_000) {
                     return;
                 }
+                uint8 unitId = uint8(i);
                 RowData_Units memory unit = ITables(proxy).getUnitsRow(
                     playerId,
                     unitId
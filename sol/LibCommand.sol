@@ -7,13 +7,16 @@ uint8 constant NilBuildingId = 0;
 enum WorkerCommandType {
     Idle,
     Gather,
-    Build
+    Build,
+    Repair
 }
 
 enum FighterCommandType {
     HoldPosition,
     AttackBuilding,
-    AttackUnit
+    AttackUnit,
+    AttackMove,
+    Patrol
 }
 
 library LibCommand {
@@ -52,6 +55,13 @@ library LibCommand {
         return newWorkerCommand(WorkerCommandType.Build, playerId, buildingId);
     }
 
+    function assignWorkerToRepair(
+        uint8 playerId,
+        uint8 buildingId
+    ) internal pure returns (uint64) {
+        return newWorkerCommand(WorkerCommandType.Repair, playerId, buildingId);
+    }
+
     function parseWorkerCommand(
         uint64 cmd
     ) internal pure returns (WorkerCommandType, uint8, uint8) {
@@ -101,6 +111,21 @@ library LibCommand {
             );
     }
 
+    function assignFighterToAttackMove(
+        uint16 x,
+        uint16 y
+    ) internal pure returns (uint64) {
+        return newFighterCommand(FighterCommandType.AttackMove, x, y);
+    }
+
+    // Patrol waypoints are passed separately as the command path in commandExtra and commandMeta
+    function assignFighterToPatrol(
+        uint16 x,
+        uint16 y
+    ) internal pure returns (uint64) {
+        return newFighterCommand(FighterCommandType.Patrol, x, y);
+    }
+
     function parseFighterCommand(
         uint64 cmd
     ) internal pure returns (FighterCommandType, uint16, uint16) {
@@ -24,4 +24,40 @@ contract PermissionedGame is Game {
     ) public override onlyPlayer(action.playerId) {
         super.createUnit(action);
     }
+
+    function assignUnits(
+        ActionData_AssignUnits memory action
+    ) public override onlyPlayer(action.playerId) {
+        super.assignUnits(action);
+    }
+
+    function cancelUnit(
+        ActionData_CancelUnit memory action
+    ) public override onlyPlayer(action.playerId) {
+        super.cancelUnit(action);
+    }
+
+    function placeBuilding(
+        ActionData_PlaceBuilding memory action
+    ) public override onlyPlayer(action.playerId) {
+        super.placeBuilding(action);
+    }
+
+    function demolishBuilding(
+        ActionData_DemolishBuilding memory action
+    ) public override onlyPlayer(action.playerId) {
+        super.demolishBuilding(action);
+    }
+
+    function requestPause(
+        ActionData_RequestPause memory action
+    ) public override onlyPlayer(action.playerId) {
+        super.requestPause(action);
+    }
+
+    function resume(
+        ActionData_Resume memory action
+    ) public override onlyPlayer(action.playerId) {
+        super.resume(action);
+    }
 }
@@ -34,6 +34,12 @@ library UnitPrototypeAdder {
                 airStrength: 15,
                 attackCooldown: 3,
                 attackRange: 4,
+                visionRadius: 5,
+                splashRadius: 0,
+                splashFalloff: 0,
+                repairCooldown: 0,
+                repairIntegrity: 0,
+                repairResourceCost: 0,
                 isAssault: false,
                 isConfrontational: true,
                 isWorker: false,
@@ -54,6 +60,12 @@ library UnitPrototypeAdder {
                 airStrength: 3,
                 attackCooldown: 2,
                 attackRange: 2,
+                visionRadius: 4,
+                splashRadius: 0,
+                splashFalloff: 0,
+                repairCooldown: 0,
+                repairIntegrity: 0,
+                repairResourceCost: 0,
                 isAssault: true,
                 isConfrontational: true,
                 isWorker: false,
@@ -74,6 +86,12 @@ library UnitPrototypeAdder {
                 airStrength: 3,
                 attackCooldown: 4,
                 attackRange: 3,
+                visionRadius: 4,
+                splashRadius: 1,
+                splashFalloff: 50,
+                repairCooldown: 0,
+                repairIntegrity: 0,
+                repairResourceCost: 0,
                 isAssault: false,
                 isConfrontational: false,
                 isWorker: false,
@@ -94,6 +112,12 @@ library UnitPrototypeAdder {
                 airStrength: 0,
                 attackCooldown: 0,
                 attackRange: 0,
+                visionRadius: 2,
+                splashRadius: 0,
+                splashFalloff: 0,
+                repairCooldown: 2,
+                repairIntegrity: 5,
+                repairResourceCost: 5,
                 isAssault: false,
                 isConfrontational: true,
                 isWorker: true,
@@ -114,6 +138,12 @@ library UnitPrototypeAdder {
                 airStrength: 3,
                 attackCooldown: 1,
                 attackRange: 3,
+                visionRadius: 4,
+                splashRadius: 0,
+                splashFalloff: 0,
+                repairCooldown: 0,
+                repairIntegrity: 0,
+                repairResourceCost: 0,
                 isAssault: false,
                 isConfrontational: true,
                 isWorker: false,
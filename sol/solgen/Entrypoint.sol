@@ -25,7 +25,7 @@ abstract contract Entrypoint is ArchBase {
     function _executeAction(uint32 actionId, bytes memory actionData) private {
         if (actionId == 0x3eaf5d9f) {
             tick();
-        } else if (actionId == 0xeaba9837) {
+        } else if (actionId == 0xbaf87e7a) {
             ActionData_Initialize memory action = abi.decode(
                 actionData,
                 (ActionData_Initialize)
@@ -45,30 +45,84 @@ abstract contract Entrypoint is ArchBase {
                 (ActionData_AssignUnit)
             );
             assignUnit(action);
+        } else if (actionId == 0x63e21ea7) {
+            ActionData_AssignUnits memory action = abi.decode(
+                actionData,
+                (ActionData_AssignUnits)
+            );
+            assignUnits(action);
         } else if (actionId == 0xd74de075) {
             ActionData_PlaceBuilding memory action = abi.decode(
                 actionData,
                 (ActionData_PlaceBuilding)
             );
             placeBuilding(action);
-        } else if (actionId == 0xb44ff0c5) {
+        } else if (actionId == 0x9fb278a8) {
+            ActionData_Surrender memory action = abi.decode(
+                actionData,
+                (ActionData_Surrender)
+            );
+            surrender(action);
+        } else if (actionId == 0xef28b525) {
+            ActionData_RequestPause memory action = abi.decode(
+                actionData,
+                (ActionData_RequestPause)
+            );
+            requestPause(action);
+        } else if (actionId == 0x1cdaebf7) {
+            ActionData_Resume memory action = abi.decode(
+                actionData,
+                (ActionData_Resume)
+            );
+            resume(action);
+        } else if (actionId == 0x96693706) {
             ActionData_AddPlayer memory action = abi.decode(
                 actionData,
                 (ActionData_AddPlayer)
             );
             addPlayer(action);
-        } else if (actionId == 0xa5e592f4) {
+        } else if (actionId == 0x999c3497) {
             ActionData_AddUnitPrototype memory action = abi.decode(
                 actionData,
                 (ActionData_AddUnitPrototype)
             );
             addUnitPrototype(action);
-        } else if (actionId == 0x982d778d) {
+        } else if (actionId == 0x6fca1096) {
             ActionData_AddBuildingPrototype memory action = abi.decode(
                 actionData,
                 (ActionData_AddBuildingPrototype)
             );
             addBuildingPrototype(action);
+        } else if (actionId == 0x9e6ae248) {
+            ActionData_SetDamage memory action = abi.decode(
+                actionData,
+                (ActionData_SetDamage)
+            );
+            setDamage(action);
+        } else if (actionId == 0x98536e12) {
+            ActionData_SetMineReserve memory action = abi.decode(
+                actionData,
+                (ActionData_SetMineReserve)
+            );
+            setMineReserve(action);
+        } else if (actionId == 0x438ca355) {
+            ActionData_SetTerrain memory action = abi.decode(
+                actionData,
+                (ActionData_SetTerrain)
+            );
+            setTerrain(action);
+        } else if (actionId == 0xfcfc2341) {
+            ActionData_CancelUnit memory action = abi.decode(
+                actionData,
+                (ActionData_CancelUnit)
+            );
+            cancelUnit(action);
+        } else if (actionId == 0x8bc3817c) {
+            ActionData_DemolishBuilding memory action = abi.decode(
+                actionData,
+                (ActionData_DemolishBuilding)
+            );
+            demolishBuilding(action);
         } else {
             revert("Entrypoint: Invalid action ID");
         }
@@ -90,12 +144,30 @@ abstract contract Entrypoint is ArchBase {
         revert("not implemented");
     }
 
+    function assignUnits(ActionData_AssignUnits memory action) public virtual {
+        revert("not implemented");
+    }
+
     function placeBuilding(
         ActionData_PlaceBuilding memory action
     ) public virtual {
         revert("not implemented");
     }
 
+    function surrender(ActionData_Surrender memory action) public virtual {
+        revert("not implemented");
+    }
+
+    function requestPause(
+        ActionData_RequestPause memory action
+    ) public virtual {
+        revert("not implemented");
+    }
+
+    function resume(ActionData_Resume memory action) public virtual {
+        revert("not implemented");
+    }
+
     function addPlayer(ActionData_AddPlayer memory action) public virtual {
         revert("not implemented");
     }
@@ -111,4 +183,28 @@ abstract contract Entrypoint is ArchBase {
     ) public virtual {
         revert("not implemented");
     }
+
+    function setDamage(ActionData_SetDamage memory action) public virtual {
+        revert("not implemented");
+    }
+
+    function setMineReserve(
+        ActionData_SetMineReserve memory action
+    ) public virtual {
+        revert("not implemented");
+    }
+
+    function setTerrain(ActionData_SetTerrain memory action) public virtual {
+        revert("not implemented");
+    }
+
+    function cancelUnit(ActionData_CancelUnit memory action) public virtual {
+        revert("not implemented");
+    }
+
+    function demolishBuilding(
+        ActionData_DemolishBuilding memory action
+    ) public virtual {
+        revert("not implemented");
+    }
 }
@@ -6,6 +6,10 @@ pragma solidity >=0.8.0;
 struct ActionData_Initialize {
     uint16 width;
     uint16 height;
+    uint32 maxTicks;
+    uint8 ticksPerBlock;
+    uint8 demolitionRefundPercent;
+    uint16 pathFindNodeBudget;
 }
 
 struct ActionData_CreateUnit {
@@ -23,6 +27,17 @@ struct ActionData_AssignUnit {
     uint8 commandMeta;
 }
 
+struct ActionData_AssignUnits {
+    uint8 playerId;
+    uint64 unitMask0;
+    uint64 unitMask1;
+    uint64 unitMask2;
+    uint64 unitMask3;
+    uint64 command;
+    uint64 commandExtra;
+    uint8 commandMeta;
+}
+
 struct ActionData_PlaceBuilding {
     uint8 playerId;
     uint8 buildingType;
@@ -30,6 +45,18 @@ struct ActionData_PlaceBuilding {
     uint16 y;
 }
 
+struct ActionData_Surrender {
+    uint8 playerId;
+}
+
+struct ActionData_RequestPause {
+    uint8 playerId;
+}
+
+struct ActionData_Resume {
+    uint8 playerId;
+}
+
 struct ActionData_AddPlayer {
     uint16 spawnAreaX;
     uint16 spawnAreaY;
@@ -38,6 +65,7 @@ struct ActionData_AddPlayer {
     uint16 workerPortX;
     uint16 workerPortY;
     uint8 unpurgeableUnitCount;
+    uint8 teamId;
 }
 
 struct ActionData_AddUnitPrototype {
@@ -51,6 +79,12 @@ struct ActionData_AddUnitPrototype {
     uint8 airStrength;
     uint8 attackRange;
     uint8 attackCooldown;
+    uint8 visionRadius;
+    uint8 splashRadius;
+    uint8 splashFalloff;
+    uint8 repairCooldown;
+    uint8 repairIntegrity;
+    uint8 repairResourceCost;
     bool isAssault;
     bool isConfrontational;
     bool isWorker;
@@ -67,8 +101,37 @@ struct ActionData_AddBuildingPrototype {
     uint8 mineTime;
     uint8 maxIntegrity;
     uint8 buildingTime;
+    uint8 visionRadius;
     bool isArmory;
     bool isEnvironment;
+    uint16 resourceReserve;
+}
+
+struct ActionData_SetDamage {
+    uint8 attackerType;
+    uint8 targetType;
+    uint8 strength;
+}
+
+struct ActionData_SetMineReserve {
+    uint8 buildingId;
+    uint16 resourceReserve;
+}
+
+struct ActionData_SetTerrain {
+    uint16 x;
+    uint16 y;
+    uint8 terrain;
+}
+
+struct ActionData_CancelUnit {
+    uint8 playerId;
+    uint8 unitId;
+}
+
+struct ActionData_DemolishBuilding {
+    uint8 playerId;
+    uint8 buildingId;
 }
 
 interface IActions {
@@ -82,7 +145,11 @@ interface IActions {
     function start() external;
     function createUnit(ActionData_CreateUnit memory action) external;
     function assignUnit(ActionData_AssignUnit memory action) external;
+    function assignUnits(ActionData_AssignUnits memory action) external;
     function placeBuilding(ActionData_PlaceBuilding memory action) external;
+    function surrender(ActionData_Surrender memory action) external;
+    function requestPause(ActionData_RequestPause memory action) external;
+    function resume(ActionData_Resume memory action) external;
     function addPlayer(ActionData_AddPlayer memory action) external;
     function addUnitPrototype(
         ActionData_AddUnitPrototype memory action
@@ -90,4 +157,11 @@ interface IActions {
     function addBuildingPrototype(
         ActionData_AddBuildingPrototype memory action
     ) external;
+    function setDamage(ActionData_SetDamage memory action) external;
+    function setMineReserve(ActionData_SetMineReserve memory action) external;
+    function setTerrain(ActionData_SetTerrain memory action) external;
+    function cancelUnit(ActionData_CancelUnit memory action) external;
+    function demolishBuilding(
+        ActionData_DemolishBuilding memory action
+    ) external;
 }
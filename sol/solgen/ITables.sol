@@ -12,6 +12,17 @@ struct RowData_Meta {
     bool isInitialized;
     bool hasStarted;
     uint32 creationBlockNumber;
+    uint32 maxTicks;
+    bool isGameOver;
+    uint8 winnerId;
+    uint32 endTick;
+    bool isPaused;
+    uint8 pausedBy;
+    uint32 pausedTicks;
+    uint32 currentPauseTicks;
+    uint8 ticksPerBlock;
+    uint8 demolitionRefundPercent;
+    uint16 pathFindNodeBudget;
 }
 
 struct RowData_Players {
@@ -27,11 +38,15 @@ struct RowData_Players {
     uint8 computeSupply;
     uint8 computeDemand;
     uint8 unitCount;
+    uint8 lastUnitId;
     uint8 buildingCount;
     uint8 buildingPayQueuePointer;
     uint8 buildingBuildQueuePointer;
     uint8 unitPayQueuePointer;
     uint8 unpurgeableUnitCount;
+    uint8 teamId;
+    bool isEliminated;
+    bool isPauseRequested;
 }
 
 struct RowData_Board {
@@ -42,6 +57,7 @@ struct RowData_Board {
     uint8 hoverUnitId;
     uint8 airPlayerId;
     uint8 airUnitId;
+    uint8 terrain;
 }
 
 struct RowData_Units {
@@ -65,6 +81,7 @@ struct RowData_Buildings {
     uint8 state;
     uint8 integrity;
     uint32 timestamp;
+    uint16 resourceReserve;
 }
 
 struct RowData_UnitPrototypes {
@@ -78,6 +95,12 @@ struct RowData_UnitPrototypes {
     uint8 airStrength;
     uint8 attackRange;
     uint8 attackCooldown;
+    uint8 visionRadius;
+    uint8 splashRadius;
+    uint8 splashFalloff;
+    uint8 repairCooldown;
+    uint8 repairIntegrity;
+    uint8 repairResourceCost;
     bool isAssault;
     bool isConfrontational;
     bool isWorker;
@@ -94,8 +117,15 @@ struct RowData_BuildingPrototypes {
     uint8 mineTime;
     uint8 maxIntegrity;
     uint8 buildingTime;
+    uint8 visionRadius;
     bool isArmory;
     bool isEnvironment;
+    uint16 resourceReserve;
+}
+
+struct RowData_DamageMatrix {
+    uint8 strength;
+    bool isSet;
 }
 
 interface ITables {
@@ -121,4 +151,8 @@ interface ITables {
     function getBuildingPrototypesRow(
         uint8 buildingType
     ) external view returns (RowData_BuildingPrototypes memory);
+    function getDamageMatrixRow(
+        uint8 attackerType,
+        uint8 targetType
+    ) external view returns (RowData_DamageMatrix memory);
 }
@@ -7,6 +7,7 @@ package main
 // Ghosts
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"image"
@@ -34,6 +35,7 @@ import (
 	"github.com/concrete-eth/ark-royale/client/game"
 	tables_contract "github.com/concrete-eth/ark-royale/gogen/abigen/tables"
 	"github.com/concrete-eth/ark-royale/gogen/archmod"
+	"github.com/concrete-eth/ark-royale/rts"
 	"github.com/hajimehoshi/ebiten/v2"
 
 	snapshot_types "github.com/concrete-eth/archetype/snapshot/types"
@@ -51,6 +53,7 @@ type URLParams struct {
 	WsURL       string
 	Interpolate bool
 	Debug       bool
+	Record      bool
 	BlockTime   time.Duration
 	Delay       time.Duration
 }
@@ -90,6 +93,9 @@ func getURLParams() (URLParams, error) {
 	paramValue = queryParams.Get("debug")
 	debug := strings.ToLower(paramValue) == "true"
 
+	paramValue = queryParams.Get("record")
+	record := strings.ToLower(paramValue) == "true"
+
 	paramValue = queryParams.Get("blockTime")
 	var blockTimeDuration time.Duration
 	if paramValue == "" {
@@ -124,6 +130,7 @@ func getURLParams() (URLParams, error) {
 		WsURL:       wsURL,
 		Interpolate: interpolate,
 		Debug:       debug,
+		Record:      record,
 		BlockTime:   blockTimeDuration,
 		Delay:       delayDuration,
 	}, nil
@@ -215,6 +222,23 @@ func logCrit(err error) {
 	os.Exit(0)
 }
 
+// Exposes a downloadReplay function to the page that saves everything recorded so far to a replay file.
+func registerReplayDownload(replay *bytes.Buffer, gameAddress common.Address) {
+	js.Global().Set("downloadReplay", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		window := js.Global()
+		data := window.Get("Uint8Array").New(replay.Len())
+		js.CopyBytesToJS(data, replay.Bytes())
+		blob := window.Get("Blob").New([]interface{}{data}, map[string]interface{}{"type": "application/gzip"})
+		blobUrl := window.Get("URL").Call("createObjectURL", blob)
+		link := window.Get("document").Call("createElement", "a")
+		link.Set("href", blobUrl)
+		link.Set("download", "ark-royale-"+gameAddress.Hex()+".replay")
+		link.Call("click")
+		window.Get("URL").Call("revokeObjectURL", blobUrl)
+		return nil
+	}))
+}
+
 func runGameClient(clientConfig core.ClientConfig, params URLParams, privateKeyHex string) {
 
 	// Connect to rpc
@@ -278,7 +302,8 @@ func runGameClient(clientConfig core.ClientConfig, params URLParams, privateKeyH
 	if err != nil {
 		log.Error("Failed to get most recent snapshot metadata", "err", err)
 	} else {
-		mustLoadSnapshot = mostRecentSnapshotMetadata.Status == snapshot_types.SnapshotStatus_Done
+		// Replays start from the block the match was created in, so recording clients sync every block
+		mustLoadSnapshot = mostRecentSnapshotMetadata.Status == snapshot_types.SnapshotStatus_Done && !params.Record
 	}
 
 	// Instantiate the kv store
@@ -389,9 +414,22 @@ func runGameClient(clientConfig core.ClientConfig, params URLParams, privateKeyH
 		clientPlayerId = 1
 	}
 
+	// Create replay recorder
+	var recorder *rts.ActionRecorder
+	if params.Record {
+		replay := new(bytes.Buffer)
+		if recorder, err = rts.NewActionRecorder(replay, uint64(metaRow.TicksPerBlock)); err != nil {
+			logCrit(fmt.Errorf("Failed to create replay recorder: %v", err))
+		}
+		defer recorder.Close()
+		registerReplayDownload(replay, params.GameAddress)
+		log.Info("Recording replay, call downloadReplay() to save it")
+	}
+
 	// Create headless client
-	hl := core.NewHeadlessClient(kv, io)
+	hl := core.NewHeadlessClient(kv, io, blockTime, startingBlockNumber, uint64(metaRow.TicksPerBlock), recorder)
 	hl.SetPlayerId(clientPlayerId)
+	hl.EnableStateVerification(rpcClient, params.GameAddress)
 
 	log.Info("Started headless client")
 
@@ -204,7 +204,8 @@ func (s *Sidecar) cleanup() error {
 				return fmt.Errorf("failed to get meta row: %w", err)
 			}
 
-			evict := s.config.EvictionThreshold > 0 && currentBlockNumber-uint64(metaRow.CreationBlockNumber) > s.config.EvictionThreshold
+			// Finished games no longer need to be ticked
+			evict := metaRow.IsGameOver || s.config.EvictionThreshold > 0 && currentBlockNumber-uint64(metaRow.CreationBlockNumber) > s.config.EvictionThreshold
 			if evict {
 				err := s.rpc.Client().Call(nil, "arch_deleteSchedule", id)
 				if err != nil {